@@ -2,6 +2,7 @@ package client
 
 import (
     "context"
+    "io"
     "log"
     "time"
     
@@ -43,17 +44,8 @@ func (c *TaskClient) Close() error {
 }
 
 // Методы клиента
-func (c *TaskClient) ListTasks(ctx context.Context, userID, status string, page, pageSize int32) ([]*pb.Task, int32, error) {
-    resp, err := c.client.ListTasks(ctx, &pb.ListTasksRequest{
-        UserId:   userID,
-        Status:   status,
-        Page:     page,
-        PageSize: pageSize,
-    })
-    if err != nil {
-        return nil, 0, err
-    }
-    return resp.GetTasks(), resp.GetTotal(), nil
+func (c *TaskClient) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+    return c.client.ListTasks(ctx, req)
 }
 
 func (c *TaskClient) CreateTask(ctx context.Context, text, userID string) (*pb.Task, error) {
@@ -75,18 +67,45 @@ func (c *TaskClient) GetTask(ctx context.Context, id int32) (*pb.Task, error) {
     return resp.GetTask(), nil
 }
 
-func (c *TaskClient) UpdateTask(ctx context.Context, id int32, text, status string) (*pb.Task, error) {
-    resp, err := c.client.UpdateTask(ctx, &pb.UpdateTaskRequest{
-        Id:     id,
-        Text:   text,
-        Status: status,
-    })
+// GetTrashedTask - задача, в том числе из корзины
+func (c *TaskClient) GetTrashedTask(ctx context.Context, id int32) (*pb.Task, error) {
+    resp, err := c.client.GetTask(ctx, &pb.GetTaskRequest{Id: id, IncludeDeleted: true})
     if err != nil {
         return nil, err
     }
     return resp.GetTask(), nil
 }
 
+func (c *TaskClient) RestoreTask(ctx context.Context, id int32, userID string) (*pb.Task, error) {
+    resp, err := c.client.RestoreTask(ctx, &pb.RestoreTaskRequest{Id: id, UserId: userID})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTask(), nil
+}
+
+func (c *TaskClient) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.Task, error) {
+    resp, err := c.client.UpdateTask(ctx, req)
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTask(), nil
+}
+
+// BatchCreateTasks, BatchUpdateTasks, BatchDeleteTasks - пакет одной транзакцией,
+// результат по каждому элементу
+func (c *TaskClient) BatchCreateTasks(ctx context.Context, req *pb.BatchCreateTasksRequest) (*pb.BatchTasksResponse, error) {
+    return c.client.BatchCreateTasks(ctx, req)
+}
+
+func (c *TaskClient) BatchUpdateTasks(ctx context.Context, req *pb.BatchUpdateTasksRequest) (*pb.BatchTasksResponse, error) {
+    return c.client.BatchUpdateTasks(ctx, req)
+}
+
+func (c *TaskClient) BatchDeleteTasks(ctx context.Context, userID string, ids []int32) (*pb.BatchTasksResponse, error) {
+    return c.client.BatchDeleteTasks(ctx, &pb.BatchDeleteTasksRequest{UserId: userID, Ids: ids})
+}
+
 func (c *TaskClient) DeleteTask(ctx context.Context, id int32) (bool, error) {
     resp, err := c.client.DeleteTask(ctx, &pb.DeleteTaskRequest{Id: id})
     if err != nil {
@@ -95,17 +114,16 @@ func (c *TaskClient) DeleteTask(ctx context.Context, id int32) (bool, error) {
     return resp.GetSuccess(), nil
 }
 
-func (c *TaskClient) SearchTasks(ctx context.Context, query, userID string, page, pageSize int32) ([]*pb.Task, int32, error) {
-    resp, err := c.client.SearchTasks(ctx, &pb.SearchTasksRequest{
-        Query:    query,
-        UserId:   userID,
-        Page:     page,
-        PageSize: pageSize,
-    })
+func (c *TaskClient) SearchTasks(ctx context.Context, req *pb.SearchTasksRequest) (*pb.SearchTasksResponse, error) {
+    return c.client.SearchTasks(ctx, req)
+}
+
+func (c *TaskClient) SuggestTasks(ctx context.Context, req *pb.SuggestTasksRequest) ([]*pb.TaskSuggestion, error) {
+    resp, err := c.client.SuggestTasks(ctx, req)
     if err != nil {
-        return nil, 0, err
+        return nil, err
     }
-    return resp.GetTasks(), resp.GetTotal(), nil
+    return resp.GetSuggestions(), nil
 }
 
 func (c *TaskClient) CreateTaskWithStatus(ctx context.Context, text, userID, status string) (*pb.Task, error) {
@@ -118,4 +136,276 @@ func (c *TaskClient) CreateTaskWithStatus(ctx context.Context, text, userID, sta
         return nil, err
     }
     return resp.GetTask(), nil
-}
\ No newline at end of file
+}
+
+// CreateTaskWithOptions - создание с приоритетом, сроком и тегами
+func (c *TaskClient) CreateTaskWithOptions(ctx context.Context, req *pb.CreateTaskRequest) (*pb.Task, error) {
+    resp, err := c.client.CreateTask(ctx, req)
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTask(), nil
+}
+
+func (c *TaskClient) GetTaskHistory(ctx context.Context, taskID int32) ([]*pb.TaskTransition, error) {
+    resp, err := c.client.GetTaskHistory(ctx, &pb.GetTaskHistoryRequest{TaskId: taskID})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTransitions(), nil
+}
+
+// WatchTasks открывает стрим изменений задач; стрим живёт, пока жив ctx
+func (c *TaskClient) WatchTasks(ctx context.Context, userID, status string) (pb.TaskService_WatchTasksClient, error) {
+    return c.client.WatchTasks(ctx, &pb.WatchTasksRequest{
+        UserId: userID,
+        Status: status,
+    })
+}
+
+func (c *TaskClient) AddDependency(ctx context.Context, taskID, blockedByID int32) error {
+    _, err := c.client.AddDependency(ctx, &pb.AddDependencyRequest{TaskId: taskID, BlockedById: blockedByID})
+    return err
+}
+
+func (c *TaskClient) RemoveDependency(ctx context.Context, taskID, blockedByID int32) error {
+    _, err := c.client.RemoveDependency(ctx, &pb.RemoveDependencyRequest{TaskId: taskID, BlockedById: blockedByID})
+    return err
+}
+
+func (c *TaskClient) GetTaskTree(ctx context.Context, taskID int32) (*pb.TaskNode, error) {
+    resp, err := c.client.GetTaskTree(ctx, &pb.GetTaskTreeRequest{TaskId: taskID})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetRoot(), nil
+}
+
+func (c *TaskClient) AddComment(ctx context.Context, taskID int32, userID, body string) (*pb.Comment, error) {
+    resp, err := c.client.AddComment(ctx, &pb.AddCommentRequest{TaskId: taskID, UserId: userID, Body: body})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetComment(), nil
+}
+
+func (c *TaskClient) ListComments(ctx context.Context, taskID, page, pageSize int32) ([]*pb.Comment, int32, error) {
+    resp, err := c.client.ListComments(ctx, &pb.ListCommentsRequest{TaskId: taskID, Page: page, PageSize: pageSize})
+    if err != nil {
+        return nil, 0, err
+    }
+    return resp.GetComments(), resp.GetTotal(), nil
+}
+
+func (c *TaskClient) EditComment(ctx context.Context, taskID, commentID int32, userID, body string) (*pb.Comment, error) {
+    resp, err := c.client.EditComment(ctx, &pb.EditCommentRequest{TaskId: taskID, CommentId: commentID, UserId: userID, Body: body})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetComment(), nil
+}
+
+func (c *TaskClient) DeleteComment(ctx context.Context, taskID, commentID int32, userID string) error {
+    _, err := c.client.DeleteComment(ctx, &pb.DeleteCommentRequest{TaskId: taskID, CommentId: commentID, UserId: userID})
+    return err
+}
+
+// UploadAttachment стримит содержимое r в task-service кусками по 64 KB
+func (c *TaskClient) UploadAttachment(ctx context.Context, info *pb.AttachmentInfo, r io.Reader) (*pb.Attachment, error) {
+    stream, err := c.client.UploadAttachment(ctx)
+    if err != nil {
+        return nil, err
+    }
+    if err := stream.Send(&pb.UploadAttachmentRequest{Data: &pb.UploadAttachmentRequest_Info{Info: info}}); err != nil && err != io.EOF {
+        return nil, err
+    }
+
+    buf := make([]byte, 64*1024)
+    for {
+        n, readErr := r.Read(buf)
+        if n > 0 {
+            // io.EOF от Send - сервер уже ответил (например, квота), статус вернёт CloseAndRecv
+            err := stream.Send(&pb.UploadAttachmentRequest{Data: &pb.UploadAttachmentRequest_Chunk{Chunk: buf[:n]}})
+            if err == io.EOF {
+                break
+            }
+            if err != nil {
+                return nil, err
+            }
+        }
+        if readErr == io.EOF {
+            break
+        }
+        if readErr != nil {
+            stream.CloseSend()
+            return nil, readErr
+        }
+    }
+
+    resp, err := stream.CloseAndRecv()
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetAttachment(), nil
+}
+
+func (c *TaskClient) ListAttachments(ctx context.Context, taskID int32, userID string) ([]*pb.Attachment, error) {
+    resp, err := c.client.ListAttachments(ctx, &pb.ListAttachmentsRequest{TaskId: taskID, UserId: userID})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetAttachments(), nil
+}
+
+// DownloadAttachment - первое сообщение стрима с метаданными, дальше содержимое
+func (c *TaskClient) DownloadAttachment(ctx context.Context, taskID, attachmentID int32, userID string) (pb.TaskService_DownloadAttachmentClient, error) {
+    return c.client.DownloadAttachment(ctx, &pb.DownloadAttachmentRequest{TaskId: taskID, AttachmentId: attachmentID, UserId: userID})
+}
+
+func (c *TaskClient) DeleteAttachment(ctx context.Context, taskID, attachmentID int32, userID string) error {
+    _, err := c.client.DeleteAttachment(ctx, &pb.DeleteAttachmentRequest{TaskId: taskID, AttachmentId: attachmentID, UserId: userID})
+    return err
+}
+
+func (c *TaskClient) AddTaskMember(ctx context.Context, taskID int32, userID string, role pb.MemberRole) (*pb.Task, error) {
+    resp, err := c.client.AddTaskMember(ctx, &pb.AddTaskMemberRequest{TaskId: taskID, UserId: userID, Role: role})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTask(), nil
+}
+
+func (c *TaskClient) RemoveTaskMember(ctx context.Context, taskID int32, userID string, role pb.MemberRole) (*pb.Task, error) {
+    resp, err := c.client.RemoveTaskMember(ctx, &pb.RemoveTaskMemberRequest{TaskId: taskID, UserId: userID, Role: role})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTask(), nil
+}
+
+func (c *TaskClient) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.Project, error) {
+    resp, err := c.client.CreateProject(ctx, req)
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetProject(), nil
+}
+
+func (c *TaskClient) GetProject(ctx context.Context, id int32) (*pb.Project, error) {
+    resp, err := c.client.GetProject(ctx, &pb.GetProjectRequest{Id: id})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetProject(), nil
+}
+
+func (c *TaskClient) ListProjects(ctx context.Context, userID string) ([]*pb.Project, error) {
+    resp, err := c.client.ListProjects(ctx, &pb.ListProjectsRequest{UserId: userID})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetProjects(), nil
+}
+
+func (c *TaskClient) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.Project, error) {
+    resp, err := c.client.UpdateProject(ctx, req)
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetProject(), nil
+}
+
+func (c *TaskClient) DeleteProject(ctx context.Context, id int32) error {
+    _, err := c.client.DeleteProject(ctx, &pb.DeleteProjectRequest{Id: id})
+    return err
+}
+
+func (c *TaskClient) SetProjectMember(ctx context.Context, projectID int32, userID, role string) (*pb.Project, error) {
+    resp, err := c.client.SetProjectMember(ctx, &pb.SetProjectMemberRequest{ProjectId: projectID, UserId: userID, Role: role})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetProject(), nil
+}
+
+func (c *TaskClient) RemoveProjectMember(ctx context.Context, projectID int32, userID string) (*pb.Project, error) {
+    resp, err := c.client.RemoveProjectMember(ctx, &pb.RemoveProjectMemberRequest{ProjectId: projectID, UserId: userID})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetProject(), nil
+}
+
+func (c *TaskClient) CreateTaskTemplate(ctx context.Context, tmpl *pb.TaskTemplate) (*pb.TaskTemplate, error) {
+    resp, err := c.client.CreateTaskTemplate(ctx, &pb.CreateTaskTemplateRequest{Template: tmpl})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTemplate(), nil
+}
+
+func (c *TaskClient) GetTaskTemplate(ctx context.Context, id int32) (*pb.TaskTemplate, error) {
+    resp, err := c.client.GetTaskTemplate(ctx, &pb.GetTaskTemplateRequest{Id: id})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTemplate(), nil
+}
+
+func (c *TaskClient) ListTaskTemplates(ctx context.Context, userID string) ([]*pb.TaskTemplate, error) {
+    resp, err := c.client.ListTaskTemplates(ctx, &pb.ListTaskTemplatesRequest{UserId: userID})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTemplates(), nil
+}
+
+func (c *TaskClient) UpdateTaskTemplate(ctx context.Context, req *pb.UpdateTaskTemplateRequest) (*pb.TaskTemplate, error) {
+    resp, err := c.client.UpdateTaskTemplate(ctx, req)
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetTemplate(), nil
+}
+
+func (c *TaskClient) DeleteTaskTemplate(ctx context.Context, id int32) error {
+    _, err := c.client.DeleteTaskTemplate(ctx, &pb.DeleteTaskTemplateRequest{Id: id})
+    return err
+}
+
+func (c *TaskClient) CreateSavedView(ctx context.Context, view *pb.SavedView) (*pb.SavedView, error) {
+    resp, err := c.client.CreateSavedView(ctx, &pb.CreateSavedViewRequest{View: view})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetView(), nil
+}
+
+func (c *TaskClient) GetSavedView(ctx context.Context, id int32) (*pb.SavedView, error) {
+    resp, err := c.client.GetSavedView(ctx, &pb.GetSavedViewRequest{Id: id})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetView(), nil
+}
+
+func (c *TaskClient) ListSavedViews(ctx context.Context, userID string) ([]*pb.SavedView, error) {
+    resp, err := c.client.ListSavedViews(ctx, &pb.ListSavedViewsRequest{UserId: userID})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetViews(), nil
+}
+
+func (c *TaskClient) UpdateSavedView(ctx context.Context, view *pb.SavedView) (*pb.SavedView, error) {
+    resp, err := c.client.UpdateSavedView(ctx, &pb.UpdateSavedViewRequest{View: view})
+    if err != nil {
+        return nil, err
+    }
+    return resp.GetView(), nil
+}
+
+func (c *TaskClient) DeleteSavedView(ctx context.Context, id int32) error {
+    _, err := c.client.DeleteSavedView(ctx, &pb.DeleteSavedViewRequest{Id: id})
+    return err
+}
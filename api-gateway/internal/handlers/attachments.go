@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"api-gateway/internal/grpc/task/pb"
+)
+
+// UploadAttachment - POST /tasks/{id}/attachments, multipart/form-data с полем file.
+// Файл не буферизуется: части multipart сразу уходят в стрим UploadAttachment
+func (h *TaskProxyHandler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.editableTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, "multipart/form-data expected", http.StatusBadRequest)
+		return
+	}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			http.Error(w, "file is required", http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, "Invalid multipart body", http.StatusBadRequest)
+			return
+		}
+		if part.FormName() != "file" {
+			part.Close()
+			continue
+		}
+
+		userID := r.Context().Value("user_id").(string)
+		attachment, err := h.taskClient.UploadAttachment(r.Context(), &pb.AttachmentInfo{
+			TaskId:      task.GetId(),
+			UserId:      userID,
+			Name:        part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+		}, part)
+		part.Close()
+		if err != nil {
+			writeGRPCError(w, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(attachment)
+		return
+	}
+}
+
+// ListAttachments - GET /tasks/{id}/attachments
+func (h *TaskProxyHandler) ListAttachments(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.visibleTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	attachments, err := h.taskClient.ListAttachments(r.Context(), task.GetId(), r.Context().Value("user_id").(string))
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"attachments": attachments,
+	})
+}
+
+// DownloadAttachment - GET /tasks/{id}/attachments/{attachment_id}: содержимое файла
+func (h *TaskProxyHandler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.visibleTask(w, r, "id")
+	if !ok {
+		return
+	}
+	attachmentID, ok := attachmentIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	stream, err := h.taskClient.DownloadAttachment(r.Context(), task.GetId(), attachmentID, r.Context().Value("user_id").(string))
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	attachment := first.GetAttachment()
+
+	w.Header().Set("Content-Type", attachment.GetContentType())
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.GetSize(), 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.GetName()}))
+	w.Header().Set("ETag", `"`+attachment.GetChecksum()+`"`)
+
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			// заголовки уже отправлены, клиент увидит оборванный ответ
+			log.Printf("[DownloadAttachment] stream error: %v", err)
+			return
+		}
+		if _, err := w.Write(msg.GetChunk()); err != nil {
+			return
+		}
+	}
+}
+
+// DeleteAttachment - DELETE /tasks/{id}/attachments/{attachment_id}
+func (h *TaskProxyHandler) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.editableTask(w, r, "id")
+	if !ok {
+		return
+	}
+	attachmentID, ok := attachmentIDParam(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.taskClient.DeleteAttachment(r.Context(), task.GetId(), attachmentID, r.Context().Value("user_id").(string)); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func attachmentIDParam(w http.ResponseWriter, r *http.Request) (int32, bool) {
+	id := parseInt(r.PathValue("attachment_id"), 0)
+	if id == 0 {
+		http.Error(w, "Invalid attachment ID", http.StatusBadRequest)
+		return 0, false
+	}
+	return int32(id), true
+}
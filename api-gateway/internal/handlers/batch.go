@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"api-gateway/internal/grpc/task/pb"
+)
+
+// batchResult - результат элемента пакета: HTTP статус, как у одиночного запроса
+type batchResult struct {
+	Index  int32    `json:"index"`
+	Status int      `json:"status"`
+	Task   *pb.Task `json:"task,omitempty"`
+	Error  string   `json:"error,omitempty"`
+}
+
+// BatchCreateTasks - POST /tasks:batchCreate {"tasks": [{"text": "...", "priority": "high"}, ...]}
+func (h *TaskProxyHandler) BatchCreateTasks(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	var req struct {
+		Tasks []struct {
+			Text      string   `json:"text"`
+			Priority  string   `json:"priority"`
+			DueAt     string   `json:"due_at"`
+			Tags      []string `json:"tags"`
+			ParentID  int32    `json:"parent_id"`
+			Assignees []string `json:"assignees"`
+			Watchers  []string `json:"watchers"`
+			ProjectID int32    `json:"project_id"`
+		} `json:"tasks"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	batchReq := &pb.BatchCreateTasksRequest{UserId: userID}
+	for i, t := range req.Tasks {
+		item := &pb.CreateTaskRequest{
+			Text:      t.Text,
+			Tags:      t.Tags,
+			ParentId:  t.ParentID,
+			Assignees: t.Assignees,
+			Watchers:  t.Watchers,
+			ProjectId: t.ProjectID,
+		}
+		var err error
+		if item.Priority, err = parsePriority(t.Priority); err != nil {
+			http.Error(w, fmt.Sprintf("tasks[%d]: %v", i, err), http.StatusBadRequest)
+			return
+		}
+		if item.DueAt, err = parseTimestamp(t.DueAt); err != nil {
+			http.Error(w, fmt.Sprintf("tasks[%d]: due_at: %v", i, err), http.StatusBadRequest)
+			return
+		}
+		batchReq.Items = append(batchReq.Items, item)
+	}
+
+	resp, err := h.taskClient.BatchCreateTasks(r.Context(), batchReq)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	writeBatch(w, resp, http.StatusCreated)
+}
+
+// BatchUpdateTasks - POST /tasks:batchUpdate {"tasks": [{"id": 1, "version": 3, "status": "DONE"}, ...]}
+// version - как If-Match у PUT /tasks/{id}; остальные поля - как в PUT
+func (h *TaskProxyHandler) BatchUpdateTasks(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	var req struct {
+		Tasks []struct {
+			ID       int32     `json:"id"`
+			Version  int32     `json:"version"`
+			Text     string    `json:"text"`
+			Status   string    `json:"status"`
+			Priority string    `json:"priority"`
+			DueAt    *string   `json:"due_at"`
+			Tags     *[]string `json:"tags"`
+		} `json:"tasks"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	batchReq := &pb.BatchUpdateTasksRequest{UserId: userID}
+	for i, t := range req.Tasks {
+		item := &pb.UpdateTaskRequest{
+			Id:              t.ID,
+			Text:            t.Text,
+			Status:          t.Status,
+			ExpectedVersion: t.Version,
+		}
+		var err error
+		if item.Priority, err = parsePriority(t.Priority); err != nil {
+			http.Error(w, fmt.Sprintf("tasks[%d]: %v", i, err), http.StatusBadRequest)
+			return
+		}
+		if t.DueAt != nil {
+			if *t.DueAt == "" {
+				item.ClearDueAt = true
+			} else if item.DueAt, err = parseTimestamp(*t.DueAt); err != nil {
+				http.Error(w, fmt.Sprintf("tasks[%d]: due_at: %v", i, err), http.StatusBadRequest)
+				return
+			}
+		}
+		if t.Tags != nil {
+			item.Tags = &pb.TagList{Names: *t.Tags}
+		}
+		batchReq.Items = append(batchReq.Items, item)
+	}
+
+	resp, err := h.taskClient.BatchUpdateTasks(r.Context(), batchReq)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	writeBatch(w, resp, http.StatusOK)
+}
+
+// BatchDeleteTasks - POST /tasks:batchDelete {"ids": [1, 2, 3]}: задачи уходят в корзину
+func (h *TaskProxyHandler) BatchDeleteTasks(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	var req struct {
+		IDs []int32 `json:"ids"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.taskClient.BatchDeleteTasks(r.Context(), userID, req.IDs)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	writeBatch(w, resp, http.StatusOK)
+}
+
+// writeBatch отдаёт результаты пакета; сам ответ - 200, даже если часть элементов не прошла.
+// Права на элементы проверяет task-service: чужая задача - 404, как и в одиночных запросах
+func writeBatch(w http.ResponseWriter, resp *pb.BatchTasksResponse, okStatus int) {
+	results := make([]batchResult, 0, len(resp.GetResults()))
+	for _, res := range resp.GetResults() {
+		result := batchResult{Index: res.GetIndex(), Status: okStatus, Task: res.GetTask()}
+		if code := codes.Code(res.GetCode()); code != codes.OK {
+			result.Status = httpStatus(status.Error(code, res.GetError()))
+			result.Error = res.GetError()
+		}
+		results = append(results, result)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"results":   results,
+		"succeeded": resp.GetSucceeded(),
+		"failed":    resp.GetFailed(),
+	})
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type commentRequest struct {
+	Body string `json:"body"`
+}
+
+// ListComments - GET /tasks/{id}/comments?page=1&page_size=50
+func (h *TaskProxyHandler) ListComments(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.visibleTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	page := parseInt(r.URL.Query().Get("page"), 1)
+	pageSize := parseInt(r.URL.Query().Get("page_size"), 50)
+
+	comments, total, err := h.taskClient.ListComments(r.Context(), task.GetId(), int32(page), int32(pageSize))
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"comments":  comments,
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// AddComment - POST /tasks/{id}/comments {"body": "..."}
+func (h *TaskProxyHandler) AddComment(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.visibleTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req commentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value("user_id").(string)
+	comment, err := h.taskClient.AddComment(r.Context(), task.GetId(), userID, req.Body)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(comment)
+}
+
+// EditComment - PUT /tasks/{id}/comments/{comment_id} {"body": "..."}
+func (h *TaskProxyHandler) EditComment(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.visibleTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	commentID := parseInt(r.PathValue("comment_id"), 0)
+	if commentID == 0 {
+		http.Error(w, "Invalid comment ID", http.StatusBadRequest)
+		return
+	}
+
+	var req commentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value("user_id").(string)
+	comment, err := h.taskClient.EditComment(r.Context(), task.GetId(), int32(commentID), userID, req.Body)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(comment)
+}
+
+// DeleteComment - DELETE /tasks/{id}/comments/{comment_id}
+func (h *TaskProxyHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.visibleTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	commentID := parseInt(r.PathValue("comment_id"), 0)
+	if commentID == 0 {
+		http.Error(w, "Invalid comment ID", http.StatusBadRequest)
+		return
+	}
+
+	userID := r.Context().Value("user_id").(string)
+	if err := h.taskClient.DeleteComment(r.Context(), task.GetId(), int32(commentID), userID); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// AddDependency - POST /tasks/{id}/dependencies {"blocked_by": 5}
+func (h *TaskProxyHandler) AddDependency(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.ownedTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req struct {
+		BlockedBy int32 `json:"blocked_by"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.BlockedBy <= 0 {
+		http.Error(w, "blocked_by is required", http.StatusBadRequest)
+		return
+	}
+
+	// блокировать можно только своими задачами
+	blocker, err := h.taskClient.GetTask(r.Context(), req.BlockedBy)
+	if err != nil || blocker.GetUserId() != task.GetUserId() {
+		http.Error(w, "Blocking task not found", http.StatusNotFound)
+		return
+	}
+
+	if err := h.taskClient.AddDependency(r.Context(), task.GetId(), req.BlockedBy); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"task_id":    task.GetId(),
+		"blocked_by": req.BlockedBy,
+	})
+}
+
+// RemoveDependency - DELETE /tasks/{id}/dependencies/{blocked_by}
+func (h *TaskProxyHandler) RemoveDependency(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.ownedTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	blockedBy := parseInt(r.PathValue("blocked_by"), 0)
+	if blockedBy == 0 {
+		http.Error(w, "Invalid blocking task ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.taskClient.RemoveDependency(r.Context(), task.GetId(), int32(blockedBy)); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetTaskTree - GET /tasks/{id}/tree: подзадачи и блокирующие задачи
+func (h *TaskProxyHandler) GetTaskTree(w http.ResponseWriter, r *http.Request) {
+	task, ok := h.visibleTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	tree, err := h.taskClient.GetTaskTree(r.Context(), task.GetId())
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tree)
+}
@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"api-gateway/internal/grpc/task/pb"
+)
+
+// access - уровень доступа пользователя к задаче или проекту
+type access int
+
+const (
+	accessNone  access = iota
+	accessView         // наблюдатель задачи, viewer проекта
+	accessEdit         // исполнитель задачи, editor проекта
+	accessOwner        // владелец задачи, owner проекта
+)
+
+// accessTo - доступ к задаче: по её владельцу и участникам, а для задачи
+// в проекте - не меньше, чем роль пользователя в проекте
+func (h *TaskProxyHandler) accessTo(r *http.Request, task *pb.Task) access {
+	userID := r.Context().Value("user_id").(string)
+
+	level := accessNone
+	switch {
+	case task.GetUserId() == userID:
+		return accessOwner
+	case contains(task.GetAssignees(), userID):
+		level = accessEdit
+	case contains(task.GetWatchers(), userID):
+		level = accessView
+	}
+	if task.GetProjectId() != 0 {
+		if role := h.projectRole(r, task.GetProjectId()); role > level {
+			level = role
+		}
+	}
+	return level
+}
+
+// projectRole - доступ пользователя к проекту; accessNone, если он не участник
+func (h *TaskProxyHandler) projectRole(r *http.Request, projectID int32) access {
+	project, err := h.taskClient.GetProject(r.Context(), projectID)
+	if err != nil {
+		return accessNone
+	}
+	return memberAccess(project, r.Context().Value("user_id").(string))
+}
+
+func memberAccess(project *pb.Project, userID string) access {
+	for _, m := range project.GetMembers() {
+		if m.GetUserId() != userID {
+			continue
+		}
+		switch m.GetRole() {
+		case "owner":
+			return accessOwner
+		case "editor":
+			return accessEdit
+		case "viewer":
+			return accessView
+		}
+	}
+	return accessNone
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// taskFor загружает задачу {name} из пути и проверяет, что доступ пользователя не меньше need.
+// Задача без доступа для клиента не отличается от несуществующей
+func (h *TaskProxyHandler) taskFor(w http.ResponseWriter, r *http.Request, name string, need access) (*pb.Task, bool) {
+	id := parseInt(r.PathValue(name), 0)
+	if id == 0 {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	task, err := h.taskClient.GetTask(r.Context(), int32(id))
+	if err != nil || h.accessTo(r, task) < need {
+		http.Error(w, "Task not found", http.StatusNotFound)
+		return nil, false
+	}
+	return task, true
+}
+
+// ownedTask - задача, которой владеет пользователь (или владелец её проекта)
+func (h *TaskProxyHandler) ownedTask(w http.ResponseWriter, r *http.Request, name string) (*pb.Task, bool) {
+	return h.taskFor(w, r, name, accessOwner)
+}
+
+func (h *TaskProxyHandler) visibleTask(w http.ResponseWriter, r *http.Request, name string) (*pb.Task, bool) {
+	return h.taskFor(w, r, name, accessView)
+}
+
+func (h *TaskProxyHandler) editableTask(w http.ResponseWriter, r *http.Request, name string) (*pb.Task, bool) {
+	return h.taskFor(w, r, name, accessEdit)
+}
+
+// AddAssignee - POST /tasks/{id}/assignees {"user_id": "42"}
+func (h *TaskProxyHandler) AddAssignee(w http.ResponseWriter, r *http.Request) {
+	h.addMember(w, r, pb.MemberRole_MEMBER_ROLE_ASSIGNEE)
+}
+
+// RemoveAssignee - DELETE /tasks/{id}/assignees/{user_id}
+func (h *TaskProxyHandler) RemoveAssignee(w http.ResponseWriter, r *http.Request) {
+	h.removeMember(w, r, pb.MemberRole_MEMBER_ROLE_ASSIGNEE)
+}
+
+// AddWatcher - POST /tasks/{id}/watchers {"user_id": "42"}
+func (h *TaskProxyHandler) AddWatcher(w http.ResponseWriter, r *http.Request) {
+	h.addMember(w, r, pb.MemberRole_MEMBER_ROLE_WATCHER)
+}
+
+// RemoveWatcher - DELETE /tasks/{id}/watchers/{user_id}
+func (h *TaskProxyHandler) RemoveWatcher(w http.ResponseWriter, r *http.Request) {
+	h.removeMember(w, r, pb.MemberRole_MEMBER_ROLE_WATCHER)
+}
+
+// участников назначает владелец
+func (h *TaskProxyHandler) addMember(w http.ResponseWriter, r *http.Request, role pb.MemberRole) {
+	task, ok := h.ownedTask(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var req struct {
+		UserID string `json:"user_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.UserID == "" {
+		http.Error(w, "user_id is required", http.StatusBadRequest)
+		return
+	}
+
+	updated, err := h.taskClient.AddTaskMember(r.Context(), task.GetId(), req.UserID, role)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etag(updated.GetVersion()))
+	json.NewEncoder(w).Encode(updated)
+}
+
+// убрать участника может владелец, а участник - только себя
+func (h *TaskProxyHandler) removeMember(w http.ResponseWriter, r *http.Request, role pb.MemberRole) {
+	memberID := r.PathValue("user_id")
+	need := accessOwner
+	if memberID == r.Context().Value("user_id").(string) {
+		need = accessView
+	}
+	task, ok := h.taskFor(w, r, "id", need)
+	if !ok {
+		return
+	}
+
+	updated, err := h.taskClient.RemoveTaskMember(r.Context(), task.GetId(), memberID, role)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(updated)
+}
+
+// GetAssignedTasks - GET /me/assigned: задачи, где пользователь - исполнитель
+func (h *TaskProxyHandler) GetAssignedTasks(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+	h.listTasks(w, r, &pb.ListTasksRequest{AssigneeId: userID})
+}
+
+// GetWatchedTasks - GET /me/watching: задачи, где пользователь - наблюдатель
+func (h *TaskProxyHandler) GetWatchedTasks(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+	h.listTasks(w, r, &pb.ListTasksRequest{WatcherId: userID})
+}
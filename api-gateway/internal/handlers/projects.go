@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"api-gateway/internal/grpc/task/pb"
+)
+
+// projectFor загружает проект {id} из пути и проверяет роль пользователя в нём.
+// Не участнику проект не виден (404), участнику без нужной роли - 403
+func (h *TaskProxyHandler) projectFor(w http.ResponseWriter, r *http.Request, need access) (*pb.Project, bool) {
+	id := parseInt(r.PathValue("id"), 0)
+	if id == 0 {
+		http.Error(w, "Invalid project ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	project, err := h.taskClient.GetProject(r.Context(), int32(id))
+	if err != nil {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return nil, false
+	}
+	role := memberAccess(project, r.Context().Value("user_id").(string))
+	if role == accessNone {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return nil, false
+	}
+	if role < need {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return nil, false
+	}
+	return project, true
+}
+
+type projectSettings struct {
+	ActiveTaskLimit int32 `json:"active_task_limit"`
+}
+
+// CreateProject - POST /projects {"name": "...", "settings": {"active_task_limit": 5}}
+func (h *TaskProxyHandler) CreateProject(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	var req struct {
+		Name     string          `json:"name"`
+		Settings projectSettings `json:"settings"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	project, err := h.taskClient.CreateProject(r.Context(), &pb.CreateProjectRequest{
+		Name:     req.Name,
+		OwnerId:  userID,
+		Settings: &pb.ProjectSettings{ActiveTaskLimit: req.Settings.ActiveTaskLimit},
+	})
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(project)
+}
+
+// GetProjects - GET /projects: проекты, где пользователь - участник
+func (h *TaskProxyHandler) GetProjects(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	projects, err := h.taskClient.ListProjects(r.Context(), userID)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"projects": projects,
+	})
+}
+
+// GetProject - GET /projects/{id}
+func (h *TaskProxyHandler) GetProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := h.projectFor(w, r, accessView)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(project)
+}
+
+// UpdateProject - PUT /projects/{id}: название и настройки меняет владелец
+func (h *TaskProxyHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := h.projectFor(w, r, accessOwner)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Name     string           `json:"name"`
+		Settings *projectSettings `json:"settings"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	updateReq := &pb.UpdateProjectRequest{Id: project.GetId(), Name: req.Name}
+	if req.Settings != nil {
+		updateReq.Settings = &pb.ProjectSettings{ActiveTaskLimit: req.Settings.ActiveTaskLimit}
+	}
+	updated, err := h.taskClient.UpdateProject(r.Context(), updateReq)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(updated)
+}
+
+// DeleteProject - DELETE /projects/{id}: только пустой проект (иначе 409)
+func (h *TaskProxyHandler) DeleteProject(w http.ResponseWriter, r *http.Request) {
+	project, ok := h.projectFor(w, r, accessOwner)
+	if !ok {
+		return
+	}
+
+	if err := h.taskClient.DeleteProject(r.Context(), project.GetId()); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// SetProjectMember - PUT /projects/{id}/members/{user_id} {"role": "editor"}
+func (h *TaskProxyHandler) SetProjectMember(w http.ResponseWriter, r *http.Request) {
+	project, ok := h.projectFor(w, r, accessOwner)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Role string `json:"role"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Role == "" {
+		http.Error(w, "role is required", http.StatusBadRequest)
+		return
+	}
+
+	updated, err := h.taskClient.SetProjectMember(r.Context(), project.GetId(), r.PathValue("user_id"), req.Role)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(updated)
+}
+
+// RemoveProjectMember - DELETE /projects/{id}/members/{user_id}: владелец или сам участник
+func (h *TaskProxyHandler) RemoveProjectMember(w http.ResponseWriter, r *http.Request) {
+	memberID := r.PathValue("user_id")
+	need := accessOwner
+	if memberID == r.Context().Value("user_id").(string) {
+		need = accessView
+	}
+	project, ok := h.projectFor(w, r, need)
+	if !ok {
+		return
+	}
+
+	updated, err := h.taskClient.RemoveProjectMember(r.Context(), project.GetId(), memberID)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(updated)
+}
+
+// GetProjectTasks - GET /projects/{id}/tasks: все задачи проекта, видны любому участнику
+func (h *TaskProxyHandler) GetProjectTasks(w http.ResponseWriter, r *http.Request) {
+	project, ok := h.projectFor(w, r, accessView)
+	if !ok {
+		return
+	}
+	h.listTasks(w, r, &pb.ListTasksRequest{ProjectId: project.GetId()})
+}
+
+// SearchProjectTasks - GET /projects/{id}/tasks/search?q=...
+func (h *TaskProxyHandler) SearchProjectTasks(w http.ResponseWriter, r *http.Request) {
+	project, ok := h.projectFor(w, r, accessView)
+	if !ok {
+		return
+	}
+	h.searchTasks(w, r, &pb.SearchTasksRequest{ProjectId: project.GetId()})
+}
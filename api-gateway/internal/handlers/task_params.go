@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"api-gateway/internal/grpc/task/pb"
+)
+
+// parsePriority: "HIGH", "high" → PRIORITY_HIGH; пустая строка - не задан
+func parsePriority(s string) (pb.Priority, error) {
+	if s == "" {
+		return pb.Priority_PRIORITY_UNSPECIFIED, nil
+	}
+	p, ok := pb.Priority_value["PRIORITY_"+strings.ToUpper(strings.TrimSpace(s))]
+	if !ok || p == 0 {
+		return 0, fmt.Errorf("unknown priority %q (LOW, MEDIUM, HIGH, CRITICAL)", s)
+	}
+	return pb.Priority(p), nil
+}
+
+// parseTimestamp принимает RFC3339 (2026-01-02T15:04:05Z) или дату 2026-01-02
+func parseTimestamp(s string) (*timestamppb.Timestamp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		if t, err = time.Parse("2006-01-02", s); err != nil {
+			return nil, fmt.Errorf("expected RFC3339 time or YYYY-MM-DD date")
+		}
+	}
+	return timestamppb.New(t), nil
+}
+
+// splitList: "a,b" → [a b]
+func splitList(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// parseListParams читает фильтры и сортировку GET /tasks:
+// ?status=NEW,VALIDATION_1&priority=HIGH,CRITICAL&due_after=...&due_before=...&overdue=true&tag=a,b
+// &created_after=...&created_before=...&updated_after=...&updated_before=...&ended_after=...&ended_before=...
+// &text_prefix=...&min_attempts=1&max_attempts=3&sort=priority&order=asc
+func parseListParams(q url.Values, req *pb.ListTasksRequest) error {
+	req.Statuses = splitList(q.Get("status"))
+	for _, name := range splitList(q.Get("priority")) {
+		p, err := parsePriority(name)
+		if err != nil {
+			return err
+		}
+		req.Priorities = append(req.Priorities, p)
+	}
+
+	timestamps := []struct {
+		name string
+		dst  **timestamppb.Timestamp
+	}{
+		{"due_after", &req.DueAfter},
+		{"due_before", &req.DueBefore},
+		{"created_after", &req.CreatedAfter},
+		{"created_before", &req.CreatedBefore},
+		{"updated_after", &req.UpdatedAfter},
+		{"updated_before", &req.UpdatedBefore},
+		{"ended_after", &req.EndedAfter},
+		{"ended_before", &req.EndedBefore},
+	}
+	for _, ts := range timestamps {
+		var err error
+		if *ts.dst, err = parseTimestamp(q.Get(ts.name)); err != nil {
+			return fmt.Errorf("%s: %w", ts.name, err)
+		}
+	}
+
+	req.TextPrefix = q.Get("text_prefix")
+	var err error
+	if req.MinAttempts, err = parseOptionalInt(q.Get("min_attempts")); err != nil {
+		return fmt.Errorf("min_attempts: %w", err)
+	}
+	if req.MaxAttempts, err = parseOptionalInt(q.Get("max_attempts")); err != nil {
+		return fmt.Errorf("max_attempts: %w", err)
+	}
+
+	req.Overdue = q.Get("overdue") == "true"
+	req.Tags = splitList(q.Get("tag"))
+	// без sort остаётся сортировка, заданная вызывающим (по умолчанию created_at)
+	if sort := q.Get("sort"); sort != "" {
+		req.SortBy = sort
+	}
+
+	switch q.Get("order") {
+	case "", "desc":
+	case "asc":
+		req.SortAsc = true
+	default:
+		return fmt.Errorf("order must be asc or desc")
+	}
+	return nil
+}
+
+// parseOptionalInt: "" - не задано
+func parseOptionalInt(s string) (*int32, error) {
+	if s == "" {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < 0 {
+		return nil, fmt.Errorf("expected non-negative integer")
+	}
+	n := int32(v)
+	return &n, nil
+}
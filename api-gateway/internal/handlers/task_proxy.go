@@ -2,12 +2,17 @@ package handlers
 
 import (
     "encoding/json"
+    "fmt"
     "net/http"
     "strconv"
     "strings"
     "log"
     
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
     "api-gateway/internal/grpc/client"
+    "api-gateway/internal/grpc/task/pb"
 )
 
 type TaskProxyHandler struct {
@@ -29,43 +34,144 @@ func parseInt(s string, defaultVal int) int {
     return val
 }
 
+// httpStatus переводит gRPC код ошибки task-service в HTTP статус
+func httpStatus(err error) int {
+    switch status.Code(err) {
+    case codes.InvalidArgument:
+        return http.StatusBadRequest
+    case codes.NotFound:
+        return http.StatusNotFound
+    case codes.PermissionDenied:
+        return http.StatusForbidden
+    case codes.ResourceExhausted:
+        return http.StatusRequestEntityTooLarge
+    case codes.FailedPrecondition, codes.Aborted:
+        return http.StatusConflict
+    default:
+        return http.StatusInternalServerError
+    }
+}
+
+// writeGRPCError отдаёт клиенту сообщение из gRPC статуса
+func writeGRPCError(w http.ResponseWriter, err error) {
+    http.Error(w, status.Convert(err).Message(), httpStatus(err))
+}
+
+// etag - ETag задачи по её version
+func etag(version int32) string {
+    return `"` + strconv.Itoa(int(version)) + `"`
+}
+
+// ifMatchVersion читает version из If-Match ("3", W/"3"); "*" - текущая version задачи
+func ifMatchVersion(r *http.Request, current int32) (int32, bool) {
+    value := strings.TrimSpace(r.Header.Get("If-Match"))
+    if value == "" {
+        return 0, false
+    }
+    if value == "*" {
+        return current, true
+    }
+    value = strings.Trim(strings.TrimPrefix(value, "W/"), `"`)
+    version, err := strconv.Atoi(value)
+    if err != nil || version <= 0 {
+        return 0, false
+    }
+    return int32(version), true
+}
+
 func (h *TaskProxyHandler) GetTasks(w http.ResponseWriter, r *http.Request) {
     userID := r.Context().Value("user_id").(string)
-    
+    h.listTasks(w, r, &pb.ListTasksRequest{UserId: userID})
+}
+
+// listTasks дополняет req пагинацией и фильтрами из query и отдаёт список
+func (h *TaskProxyHandler) listTasks(w http.ResponseWriter, r *http.Request, req *pb.ListTasksRequest) {
     page := parseInt(r.URL.Query().Get("page"), 1)
     pageSize := parseInt(r.URL.Query().Get("page_size"), 10)
-    status := r.URL.Query().Get("status")
     
-    tasks, total, err := h.taskClient.ListTasks(r.Context(), userID, status, int32(page), int32(pageSize))
+    req.Page = int32(page)
+    req.PageSize = int32(pageSize)
+    req.PageToken = r.URL.Query().Get("page_token")
+    req.SkipTotal = r.URL.Query().Get("total") == "false"
+    if err := parseListParams(r.URL.Query(), req); err != nil {
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    
+    resp, err := h.taskClient.ListTasks(r.Context(), req)
     if err != nil {
-        http.Error(w, err.Error(), http.StatusInternalServerError)
+        writeGRPCError(w, err)
         return
     }
     
     w.Header().Set("Content-Type", "application/json")
-    json.NewEncoder(w).Encode(map[string]interface{}{
+    json.NewEncoder(w).Encode(pageJSON(resp.GetTasks(), resp.GetTotal(), page, pageSize, resp.GetNextPageToken()))
+}
+
+// pageJSON - страница задач для ответа; total нет, если его не считали (?total=false),
+// next_page_token - для ?page_token= следующей страницы
+func pageJSON(tasks []*pb.Task, total int32, page, pageSize int, nextPageToken string) map[string]interface{} {
+    body := map[string]interface{}{
         "tasks":     tasks,
-        "total":     total,
         "page":      page,
         "page_size": pageSize,
-    })
+    }
+    if total >= 0 {
+        body["total"] = total
+    }
+    if nextPageToken != "" {
+        body["next_page_token"] = nextPageToken
+    }
+    return body
 }
 
 func (h *TaskProxyHandler) CreateTask(w http.ResponseWriter, r *http.Request) {
     userID := r.Context().Value("user_id").(string)
     
     var req struct {
-        Text string `json:"text"`
+        Text     string   `json:"text"`
+        Priority string   `json:"priority"`
+        DueAt    string   `json:"due_at"`
+        Tags     []string `json:"tags"`
+        ParentID int32    `json:"parent_id"` // создать как подзадачу
+        Assignees []string `json:"assignees"`
+        Watchers  []string `json:"watchers"`
+        ProjectID int32    `json:"project_id"`
     }
     
     if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
         http.Error(w, "Invalid JSON", http.StatusBadRequest)
         return
     }
+
+    createReq := &pb.CreateTaskRequest{
+        Text:   req.Text,
+        UserId: userID,
+        Status: "NEW",
+        Tags:   req.Tags,
+        ParentId: req.ParentID,
+        Assignees: req.Assignees,
+        Watchers:  req.Watchers,
+        ProjectId: req.ProjectID,
+    }
+    // задачи в проекте создают его редакторы и владельцы
+    if req.ProjectID != 0 && h.projectRole(r, req.ProjectID) < accessEdit {
+        http.Error(w, "Project not found", http.StatusNotFound)
+        return
+    }
+    var err error
+    if createReq.Priority, err = parsePriority(req.Priority); err != nil {
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    if createReq.DueAt, err = parseTimestamp(req.DueAt); err != nil {
+        http.Error(w, "due_at: "+err.Error(), http.StatusBadRequest)
+        return
+    }
     
-    task, err := h.taskClient.CreateTaskWithStatus(r.Context(), req.Text, userID, "NEW")
+    task, err := h.taskClient.CreateTaskWithOptions(r.Context(), createReq)
     if err != nil {
-        http.Error(w, err.Error(), http.StatusInternalServerError)
+        writeGRPCError(w, err)
         return
     }
     
@@ -75,8 +181,6 @@ func (h *TaskProxyHandler) CreateTask(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *TaskProxyHandler) GetTaskByID(w http.ResponseWriter, r *http.Request) {
-    userID := r.Context().Value("user_id").(string)
-    
     idStr := r.URL.Path[len("/tasks/"):]
     id := parseInt(idStr, 0)
     if id == 0 {
@@ -90,12 +194,13 @@ func (h *TaskProxyHandler) GetTaskByID(w http.ResponseWriter, r *http.Request) {
         return
     }
     
-    if task.GetUserId() != userID {
+    if h.accessTo(r, task) < accessView {
         http.Error(w, "Task not found", http.StatusNotFound)
         return
     }
     
     w.Header().Set("Content-Type", "application/json")
+    w.Header().Set("ETag", etag(task.GetVersion()))
     json.NewEncoder(w).Encode(task)
 }
 
@@ -109,40 +214,73 @@ func (h *TaskProxyHandler) UpdateTask(w http.ResponseWriter, r *http.Request) {
         return
     }
     
-    // Проверяем принадлежность
+    // менять задачу могут владелец и исполнители
     task, err := h.taskClient.GetTask(r.Context(), int32(id))
     if err != nil {
         http.Error(w, "Task not found", http.StatusNotFound)
         return
     }
-    if task.GetUserId() != userID {
+    if h.accessTo(r, task) < accessEdit {
         http.Error(w, "Task not found", http.StatusNotFound)
         return
     }
+
+    // обновление только с If-Match: ETag из GET /tasks/{id}
+    version, ok := ifMatchVersion(r, task.GetVersion())
+    if !ok {
+        http.Error(w, "If-Match header with task ETag is required", http.StatusPreconditionRequired)
+        return
+    }
     
+    // due_at: null/отсутствует - не менять, "" - убрать срок; tags: отсутствует - не менять
     var req struct {
-        Text   string `json:"text"`
-        Status string `json:"status"`
+        Text     string    `json:"text"`
+        Status   string    `json:"status"`
+        Priority string    `json:"priority"`
+        DueAt    *string   `json:"due_at"`
+        Tags     *[]string `json:"tags"`
     }
     
     if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
         http.Error(w, "Invalid JSON", http.StatusBadRequest)
         return
     }
+
+    updateReq := &pb.UpdateTaskRequest{
+        Id:              int32(id),
+        Text:            req.Text,
+        Status:          req.Status,
+        UserId:          userID,
+        ExpectedVersion: version,
+    }
+    if updateReq.Priority, err = parsePriority(req.Priority); err != nil {
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    if req.DueAt != nil {
+        if *req.DueAt == "" {
+            updateReq.ClearDueAt = true
+        } else if updateReq.DueAt, err = parseTimestamp(*req.DueAt); err != nil {
+            http.Error(w, "due_at: "+err.Error(), http.StatusBadRequest)
+            return
+        }
+    }
+    if req.Tags != nil {
+        updateReq.Tags = &pb.TagList{Names: *req.Tags}
+    }
     
-    updatedTask, err := h.taskClient.UpdateTask(r.Context(), int32(id), req.Text, req.Status)
+    updatedTask, err := h.taskClient.UpdateTask(r.Context(), updateReq)
     if err != nil {
-        http.Error(w, err.Error(), http.StatusInternalServerError)
+        writeGRPCError(w, err)
         return
     }
     
     w.Header().Set("Content-Type", "application/json")
+    w.Header().Set("ETag", etag(updatedTask.GetVersion()))
     json.NewEncoder(w).Encode(updatedTask)
 }
 
 func (h *TaskProxyHandler) DeleteTask(w http.ResponseWriter, r *http.Request) {
-    userID := r.Context().Value("user_id").(string)
-    
     idStr := r.URL.Path[len("/tasks/"):]
     id := parseInt(idStr, 0)
     if id == 0 {
@@ -156,7 +294,7 @@ func (h *TaskProxyHandler) DeleteTask(w http.ResponseWriter, r *http.Request) {
         http.Error(w, "Task not found", http.StatusNotFound)
         return
     }
-    if task.GetUserId() != userID {
+    if h.accessTo(r, task) < accessOwner {
         http.Error(w, "Task not found", http.StatusNotFound)
         return
     }
@@ -169,14 +307,18 @@ func (h *TaskProxyHandler) DeleteTask(w http.ResponseWriter, r *http.Request) {
     
     w.Header().Set("Content-Type", "application/json")
     json.NewEncoder(w).Encode(map[string]interface{}{
-        "message": "task deleted",
+        "message": "task moved to trash",
         "id":      id,
     })
 }
 
 func (h *TaskProxyHandler) SearchTasks(w http.ResponseWriter, r *http.Request) {
     userID := r.Context().Value("user_id").(string)
-    
+    h.searchTasks(w, r, &pb.SearchTasksRequest{UserId: userID})
+}
+
+// searchTasks дополняет req запросом и пагинацией из query и отдаёт найденные задачи
+func (h *TaskProxyHandler) searchTasks(w http.ResponseWriter, r *http.Request, req *pb.SearchTasksRequest) {
     query := r.URL.Query().Get("q")
     if query == "" {
         http.Error(w, "query parameter 'q' is required", http.StatusBadRequest)
@@ -186,19 +328,64 @@ func (h *TaskProxyHandler) SearchTasks(w http.ResponseWriter, r *http.Request) {
     page := parseInt(r.URL.Query().Get("page"), 1)
     pageSize := parseInt(r.URL.Query().Get("page_size"), 10)
     
-    tasks, total, err := h.taskClient.SearchTasks(r.Context(), query, userID, int32(page), int32(pageSize))
+    req.Query = query
+    req.Page = int32(page)
+    req.PageSize = int32(pageSize)
+    req.PageToken = r.URL.Query().Get("page_token")
+    req.SkipTotal = r.URL.Query().Get("total") == "false"
+    req.Language = r.URL.Query().Get("lang")
+    req.Mode = r.URL.Query().Get("mode")
+    req.Facets = splitList(r.URL.Query().Get("facets"))
+    req.CreatedBucket = r.URL.Query().Get("created_bucket")
+    // фильтры GET /tasks (status, tag, due_after ...) сужают поиск
+    req.Filter = &pb.ListTasksRequest{}
+    if err := parseListParams(r.URL.Query(), req.Filter); err != nil {
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    resp, err := h.taskClient.SearchTasks(r.Context(), req)
     if err != nil {
-        http.Error(w, err.Error(), http.StatusInternalServerError)
+        writeGRPCError(w, err)
         return
     }
     
     w.Header().Set("Content-Type", "application/json")
-    json.NewEncoder(w).Encode(map[string]interface{}{
-        "tasks":     tasks,
-        "total":     total,
-        "page":      page,
-        "page_size": pageSize,
+    json.NewEncoder(w).Encode(searchJSON(resp, page, pageSize))
+}
+
+// searchJSON - страница поиска для ответа: как pageJSON, плюс hits (ранг и фрагмент
+// с <mark> для каждой задачи, в том же порядке) и facets, если их запрашивали
+func searchJSON(resp *pb.SearchTasksResponse, page, pageSize int) map[string]interface{} {
+    body := pageJSON(resp.GetTasks(), resp.GetTotal(), page, pageSize, resp.GetNextPageToken())
+    body["hits"] = resp.GetHits()
+    if len(resp.GetFacets()) > 0 {
+        body["facets"] = resp.GetFacets()
+    }
+    return body
+}
+
+// SuggestTasks - GET /tasks/suggest?q=мол&limit=10: подсказки для строки поиска по началу слов
+func (h *TaskProxyHandler) SuggestTasks(w http.ResponseWriter, r *http.Request) {
+    userID := r.Context().Value("user_id").(string)
+    
+    prefix := r.URL.Query().Get("q")
+    if prefix == "" {
+        http.Error(w, "query parameter 'q' is required", http.StatusBadRequest)
+        return
+    }
+    
+    suggestions, err := h.taskClient.SuggestTasks(r.Context(), &pb.SuggestTasksRequest{
+        Prefix: prefix,
+        UserId: userID,
+        Limit:  int32(parseInt(r.URL.Query().Get("limit"), 10)),
     })
+    if err != nil {
+        writeGRPCError(w, err)
+        return
+    }
+    
+    w.Header().Set("Content-Type", "application/json")
+    json.NewEncoder(w).Encode(map[string]interface{}{"suggestions": suggestions})
 }
 
 // CloseTask - закрытие задачи
@@ -230,7 +417,7 @@ func (h *TaskProxyHandler) CloseTask(w http.ResponseWriter, r *http.Request) {
     }
     log.Printf("[CloseTask] Task status: %s, UserID: %s", task.GetStatus(), task.GetUserId())
     
-    if task.GetUserId() != userID {
+    if h.accessTo(r, task) < accessEdit {
         log.Printf("[CloseTask] Forbidden: user %s != %s", userID, task.GetUserId())
         http.Error(w, "Forbidden", http.StatusForbidden)
         return
@@ -242,10 +429,22 @@ func (h *TaskProxyHandler) CloseTask(w http.ResponseWriter, r *http.Request) {
         return
     }
     
-    updatedTask, err := h.taskClient.UpdateTask(r.Context(), int32(id), task.GetText(), "CLOSED")
+    // If-Match необязателен: без него закрываем ту версию, которую только что прочитали
+    version, ok := ifMatchVersion(r, task.GetVersion())
+    if !ok {
+        version = task.GetVersion()
+    }
+
+    updatedTask, err := h.taskClient.UpdateTask(r.Context(), &pb.UpdateTaskRequest{
+        Id:              int32(id),
+        Text:            task.GetText(),
+        Status:          "CLOSED",
+        UserId:          userID,
+        ExpectedVersion: version,
+    })
     if err != nil {
         log.Printf("[CloseTask] UpdateTask error: %v", err)
-        http.Error(w, "Failed to close task", http.StatusInternalServerError)
+        writeGRPCError(w, err)
         return
     }
     
@@ -258,6 +457,40 @@ func (h *TaskProxyHandler) CloseTask(w http.ResponseWriter, r *http.Request) {
     })
 }
 
+// GetTaskHistory - история смены статусов задачи (GET /tasks/{id}/history)
+func (h *TaskProxyHandler) GetTaskHistory(w http.ResponseWriter, r *http.Request) {
+    idStr := strings.TrimPrefix(r.URL.Path, "/tasks/")
+    idStr = strings.TrimSuffix(idStr, "/history")
+    id := parseInt(idStr, 0)
+    if id == 0 {
+        http.Error(w, "Invalid task ID", http.StatusBadRequest)
+        return
+    }
+    
+    // Проверяем доступ
+    task, err := h.taskClient.GetTask(r.Context(), int32(id))
+    if err != nil {
+        http.Error(w, "Task not found", http.StatusNotFound)
+        return
+    }
+    if h.accessTo(r, task) < accessView {
+        http.Error(w, "Task not found", http.StatusNotFound)
+        return
+    }
+    
+    history, err := h.taskClient.GetTaskHistory(r.Context(), int32(id))
+    if err != nil {
+        writeGRPCError(w, err)
+        return
+    }
+    
+    w.Header().Set("Content-Type", "application/json")
+    json.NewEncoder(w).Encode(map[string]interface{}{
+        "task_id":     id,
+        "transitions": history,
+    })
+}
+
 // GetUserTasks - список задач пользователя
 func (h *TaskProxyHandler) GetUserTasks(w http.ResponseWriter, r *http.Request) {
     // Извлекаем user_id из URL
@@ -269,7 +502,7 @@ func (h *TaskProxyHandler) GetUserTasks(w http.ResponseWriter, r *http.Request)
         return
     }
     
-    tasks, total, err := h.taskClient.ListTasks(r.Context(), userID, "", 1, 100)
+    resp, err := h.taskClient.ListTasks(r.Context(), &pb.ListTasksRequest{UserId: userID, Page: 1, PageSize: 100})
     if err != nil {
         http.Error(w, err.Error(), http.StatusInternalServerError)
         return
@@ -277,7 +510,48 @@ func (h *TaskProxyHandler) GetUserTasks(w http.ResponseWriter, r *http.Request)
     
     w.Header().Set("Content-Type", "application/json")
     json.NewEncoder(w).Encode(map[string]interface{}{
-        "tasks": tasks,
-        "total": total,
+        "tasks": resp.GetTasks(),
+        "total": resp.GetTotal(),
     })
-}
\ No newline at end of file
+}
+
+// WatchTasks - изменения задач пользователя в реальном времени (GET /tasks/watch, Server-Sent Events)
+func (h *TaskProxyHandler) WatchTasks(w http.ResponseWriter, r *http.Request) {
+    userID := r.Context().Value("user_id").(string)
+
+    flusher, ok := w.(http.Flusher)
+    if !ok {
+        http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+        return
+    }
+
+    stream, err := h.taskClient.WatchTasks(r.Context(), userID, r.URL.Query().Get("status"))
+    if err != nil {
+        writeGRPCError(w, err)
+        return
+    }
+
+    w.Header().Set("Content-Type", "text/event-stream")
+    w.Header().Set("Cache-Control", "no-cache")
+    w.Header().Set("Connection", "keep-alive")
+    flusher.Flush()
+
+    for {
+        change, err := stream.Recv()
+        if err != nil {
+            // клиент ушёл (ctx отменён) или task-service закрыл стрим
+            if r.Context().Err() == nil {
+                log.Printf("[WatchTasks] stream closed: %v", err)
+            }
+            return
+        }
+
+        data, err := json.Marshal(change)
+        if err != nil {
+            log.Printf("[WatchTasks] marshal error: %v", err)
+            continue
+        }
+        fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", change.GetEventId(), change.GetEventType(), data)
+        flusher.Flush()
+    }
+}
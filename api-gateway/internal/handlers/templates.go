@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"api-gateway/internal/grpc/task/pb"
+)
+
+// ownedTemplate загружает шаблон {id} из пути; чужой шаблон не отличается от несуществующего
+func (h *TaskProxyHandler) ownedTemplate(w http.ResponseWriter, r *http.Request) (*pb.TaskTemplate, bool) {
+	id := parseInt(r.PathValue("id"), 0)
+	if id == 0 {
+		http.Error(w, "Invalid template ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	tmpl, err := h.taskClient.GetTaskTemplate(r.Context(), int32(id))
+	if err != nil || tmpl.GetUserId() != r.Context().Value("user_id").(string) {
+		http.Error(w, "Template not found", http.StatusNotFound)
+		return nil, false
+	}
+	return tmpl, true
+}
+
+// parseDueIn: "48h", "30m" → секунды
+func parseDueIn(s string) (int32, bool) {
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, false
+	}
+	return int32(d / time.Second), true
+}
+
+// CreateTemplate - POST /templates
+// {"text": "...", "schedule": "0 9 * * mon-fri", "timezone": "Europe/Moscow", "due_in": "8h", ...}
+func (h *TaskProxyHandler) CreateTemplate(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	var req struct {
+		Text      string   `json:"text"`
+		Schedule  string   `json:"schedule"`
+		Timezone  string   `json:"timezone"`
+		Priority  string   `json:"priority"`
+		DueIn     string   `json:"due_in"`
+		Tags      []string `json:"tags"`
+		Assignees []string `json:"assignees"`
+		Watchers  []string `json:"watchers"`
+		ProjectID int32    `json:"project_id"`
+		Paused    bool     `json:"paused"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	tmpl := &pb.TaskTemplate{
+		UserId:    userID,
+		Text:      req.Text,
+		Schedule:  req.Schedule,
+		Timezone:  req.Timezone,
+		Tags:      req.Tags,
+		Assignees: req.Assignees,
+		Watchers:  req.Watchers,
+		ProjectId: req.ProjectID,
+		Paused:    req.Paused,
+	}
+	var err error
+	if tmpl.Priority, err = parsePriority(req.Priority); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.DueIn != "" {
+		var ok bool
+		if tmpl.DueInSeconds, ok = parseDueIn(req.DueIn); !ok {
+			http.Error(w, "due_in: expected duration like 24h or 30m", http.StatusBadRequest)
+			return
+		}
+	}
+	// задачи в проекте создают его редакторы и владельцы
+	if req.ProjectID != 0 && h.projectRole(r, req.ProjectID) < accessEdit {
+		http.Error(w, "Project not found", http.StatusNotFound)
+		return
+	}
+
+	created, err := h.taskClient.CreateTaskTemplate(r.Context(), tmpl)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// GetTemplates - GET /templates: шаблоны пользователя
+func (h *TaskProxyHandler) GetTemplates(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	templates, err := h.taskClient.ListTaskTemplates(r.Context(), userID)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"templates": templates,
+	})
+}
+
+// GetTemplate - GET /templates/{id}
+func (h *TaskProxyHandler) GetTemplate(w http.ResponseWriter, r *http.Request) {
+	tmpl, ok := h.ownedTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tmpl)
+}
+
+// UpdateTemplate - PUT /templates/{id}; отсутствующие поля не меняются,
+// "due_in": "" - задачи без срока, "paused": true/false - пауза и возобновление
+func (h *TaskProxyHandler) UpdateTemplate(w http.ResponseWriter, r *http.Request) {
+	tmpl, ok := h.ownedTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Text     string    `json:"text"`
+		Schedule string    `json:"schedule"`
+		Timezone string    `json:"timezone"`
+		Priority string    `json:"priority"`
+		DueIn    *string   `json:"due_in"`
+		Tags     *[]string `json:"tags"`
+		Paused   *bool     `json:"paused"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	updateReq := &pb.UpdateTaskTemplateRequest{
+		Id:       tmpl.GetId(),
+		Text:     req.Text,
+		Schedule: req.Schedule,
+		Timezone: req.Timezone,
+	}
+	var err error
+	if updateReq.Priority, err = parsePriority(req.Priority); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.DueIn != nil {
+		if *req.DueIn == "" {
+			updateReq.ClearDueIn = true
+		} else if updateReq.DueInSeconds, ok = parseDueIn(*req.DueIn); !ok {
+			http.Error(w, "due_in: expected duration like 24h or 30m", http.StatusBadRequest)
+			return
+		}
+	}
+	if req.Tags != nil {
+		updateReq.Tags = &pb.TagList{Names: *req.Tags}
+	}
+	if req.Paused != nil {
+		updateReq.State = pb.TemplateState_TEMPLATE_STATE_ACTIVE
+		if *req.Paused {
+			updateReq.State = pb.TemplateState_TEMPLATE_STATE_PAUSED
+		}
+	}
+
+	updated, err := h.taskClient.UpdateTaskTemplate(r.Context(), updateReq)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(updated)
+}
+
+// DeleteTemplate - DELETE /templates/{id}; созданные по шаблону задачи остаются
+func (h *TaskProxyHandler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	tmpl, ok := h.ownedTemplate(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.taskClient.DeleteTaskTemplate(r.Context(), tmpl.GetId()); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"api-gateway/internal/grpc/task/pb"
+)
+
+// GetTrash - GET /tasks/trash: удалённые задачи пользователя, сначала недавно удалённые
+func (h *TaskProxyHandler) GetTrash(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	h.listTasks(w, r, &pb.ListTasksRequest{UserId: userID, Trashed: true, SortBy: "deleted_at"})
+}
+
+// RestoreTask - POST /tasks/{id}/restore: вернуть задачу из корзины может тот, кто мог её удалить
+func (h *TaskProxyHandler) RestoreTask(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	id := parseInt(r.PathValue("id"), 0)
+	if id == 0 {
+		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	task, err := h.taskClient.GetTrashedTask(r.Context(), int32(id))
+	if err != nil || h.accessTo(r, task) < accessOwner {
+		http.Error(w, "Task not found", http.StatusNotFound)
+		return
+	}
+	if task.GetDeletedAt() == nil {
+		http.Error(w, "Task is not in trash", http.StatusConflict)
+		return
+	}
+
+	restored, err := h.taskClient.RestoreTask(r.Context(), task.GetId(), userID)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etag(restored.GetVersion()))
+	json.NewEncoder(w).Encode(restored)
+}
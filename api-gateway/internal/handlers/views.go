@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/protobuf/proto"
+
+	"api-gateway/internal/grpc/task/pb"
+)
+
+// viewRequest - тело POST /views и PUT /views/{id}:
+// {"name": "Срочное", "query": "релиз", "scope": "own", "filter": {"priority": "HIGH,CRITICAL", "sort": "due_at"}}.
+// query, mode, lang - как q, mode, lang у GET /tasks/search; filter - параметры GET /tasks строками;
+// scope: own (свои задачи, по умолчанию), assigned, watching
+type viewRequest struct {
+	Name      string            `json:"name"`
+	Query     string            `json:"query"`
+	Mode      string            `json:"mode"`
+	Lang      string            `json:"lang"`
+	Scope     string            `json:"scope"`
+	ProjectID int32             `json:"project_id"`
+	Filter    map[string]string `json:"filter"`
+}
+
+// ownedView загружает представление {id} из пути; чужое не отличается от несуществующего
+func (h *TaskProxyHandler) ownedView(w http.ResponseWriter, r *http.Request) (*pb.SavedView, bool) {
+	id := parseInt(r.PathValue("id"), 0)
+	if id == 0 {
+		http.Error(w, "Invalid view ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	view, err := h.taskClient.GetSavedView(r.Context(), int32(id))
+	if err != nil || view.GetUserId() != r.Context().Value("user_id").(string) {
+		http.Error(w, "View not found", http.StatusNotFound)
+		return nil, false
+	}
+	return view, true
+}
+
+// decodeView читает тело запроса в представление; false - ответ с ошибкой уже отправлен.
+// Область видимости задач фиксируется при сохранении: фильтр выполняется от имени владельца
+func (h *TaskProxyHandler) decodeView(w http.ResponseWriter, r *http.Request) (*pb.SavedView, bool) {
+	userID := r.Context().Value("user_id").(string)
+
+	var req viewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return nil, false
+	}
+
+	params := url.Values{}
+	for name, value := range req.Filter {
+		params.Set(name, value)
+	}
+	filter := &pb.ListTasksRequest{}
+	if err := parseListParams(params, filter); err != nil {
+		http.Error(w, "filter: "+err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	switch req.Scope {
+	case "", "own":
+		// в проекте - все его задачи, как GET /projects/{id}/tasks
+		if req.ProjectID == 0 {
+			filter.UserId = userID
+		}
+	case "assigned":
+		filter.AssigneeId = userID
+	case "watching":
+		filter.WatcherId = userID
+	default:
+		http.Error(w, fmt.Sprintf("unknown scope %q (own, assigned, watching)", req.Scope), http.StatusBadRequest)
+		return nil, false
+	}
+	if req.ProjectID != 0 {
+		if h.projectRole(r, req.ProjectID) < accessView {
+			http.Error(w, "Project not found", http.StatusNotFound)
+			return nil, false
+		}
+		filter.ProjectId = req.ProjectID
+	}
+
+	return &pb.SavedView{
+		UserId:     userID,
+		Name:       req.Name,
+		Query:      req.Query,
+		SearchMode: req.Mode,
+		Language:   req.Lang,
+		Filter:     filter,
+	}, true
+}
+
+// CreateView - POST /views
+func (h *TaskProxyHandler) CreateView(w http.ResponseWriter, r *http.Request) {
+	view, ok := h.decodeView(w, r)
+	if !ok {
+		return
+	}
+
+	created, err := h.taskClient.CreateSavedView(r.Context(), view)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(created)
+}
+
+// GetViews - GET /views: представления пользователя
+func (h *TaskProxyHandler) GetViews(w http.ResponseWriter, r *http.Request) {
+	userID := r.Context().Value("user_id").(string)
+
+	views, err := h.taskClient.ListSavedViews(r.Context(), userID)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"views": views,
+	})
+}
+
+// GetView - GET /views/{id}
+func (h *TaskProxyHandler) GetView(w http.ResponseWriter, r *http.Request) {
+	view, ok := h.ownedView(w, r)
+	if !ok {
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(view)
+}
+
+// UpdateView - PUT /views/{id}: представление заменяется целиком, как при создании
+func (h *TaskProxyHandler) UpdateView(w http.ResponseWriter, r *http.Request) {
+	existing, ok := h.ownedView(w, r)
+	if !ok {
+		return
+	}
+	view, ok := h.decodeView(w, r)
+	if !ok {
+		return
+	}
+	view.Id = existing.GetId()
+
+	updated, err := h.taskClient.UpdateSavedView(r.Context(), view)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(updated)
+}
+
+// DeleteView - DELETE /views/{id}
+func (h *TaskProxyHandler) DeleteView(w http.ResponseWriter, r *http.Request) {
+	view, ok := h.ownedView(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.taskClient.DeleteSavedView(r.Context(), view.GetId()); err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetViewTasks - GET /views/{id}/tasks?page=&page_size=&page_token=&total=false:
+// без query - ListTasks по фильтру представления, с query - SearchTasks с ним же
+func (h *TaskProxyHandler) GetViewTasks(w http.ResponseWriter, r *http.Request) {
+	view, ok := h.ownedView(w, r)
+	if !ok {
+		return
+	}
+	filter := view.GetFilter()
+	// доступ к проекту мог пропасть после сохранения
+	if projectID := filter.GetProjectId(); projectID != 0 && h.projectRole(r, projectID) < accessView {
+		http.Error(w, "View not found", http.StatusNotFound)
+		return
+	}
+
+	page := parseInt(r.URL.Query().Get("page"), 1)
+	pageSize := parseInt(r.URL.Query().Get("page_size"), 10)
+	pageToken := r.URL.Query().Get("page_token")
+	skipTotal := r.URL.Query().Get("total") == "false"
+
+	if view.GetQuery() == "" {
+		req := proto.Clone(filter).(*pb.ListTasksRequest)
+		req.Page = int32(page)
+		req.PageSize = int32(pageSize)
+		req.PageToken = pageToken
+		req.SkipTotal = skipTotal
+
+		resp, err := h.taskClient.ListTasks(r.Context(), req)
+		if err != nil {
+			writeGRPCError(w, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(pageJSON(resp.GetTasks(), resp.GetTotal(), page, pageSize, resp.GetNextPageToken()))
+		return
+	}
+
+	// в поиске видимость задаёт user_id запроса (свои задачи и те, где пользователь участник)
+	// или проект; остальные условия - из фильтра
+	req := &pb.SearchTasksRequest{
+		Query:     view.GetQuery(),
+		Mode:      view.GetSearchMode(),
+		Language:  view.GetLanguage(),
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+		PageToken: pageToken,
+		SkipTotal: skipTotal,
+		ProjectId: filter.GetProjectId(),
+		Filter:    filter,
+	}
+	if req.ProjectId == 0 {
+		req.UserId = view.GetUserId()
+	}
+	resp, err := h.taskClient.SearchTasks(r.Context(), req)
+	if err != nil {
+		writeGRPCError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(searchJSON(resp, page, pageSize))
+}
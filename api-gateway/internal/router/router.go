@@ -20,8 +20,53 @@ func NewRouter(taskProxy *handlers.TaskProxyHandler, authHandler *handlers.AuthH
     r.Handle("GET /tasks/{id}",  middleware.AuthMiddleware(taskProxy.GetTaskByID))
     r.Handle("PUT /tasks/{id}",  middleware.AuthMiddleware(taskProxy.UpdateTask))
     r.Handle("DELETE /tasks/{id}", middleware.AuthMiddleware(taskProxy.DeleteTask))
+    r.HandleFunc("POST /tasks:batchCreate", middleware.AuthMiddleware(taskProxy.BatchCreateTasks))
+    r.HandleFunc("POST /tasks:batchUpdate", middleware.AuthMiddleware(taskProxy.BatchUpdateTasks))
+    r.HandleFunc("POST /tasks:batchDelete", middleware.AuthMiddleware(taskProxy.BatchDeleteTasks))
     r.HandleFunc("GET /tasks/search", middleware.AuthMiddleware(taskProxy.SearchTasks))
+    r.HandleFunc("GET /tasks/suggest", middleware.AuthMiddleware(taskProxy.SuggestTasks))
+    r.HandleFunc("GET /tasks/trash", middleware.AuthMiddleware(taskProxy.GetTrash))
+    r.HandleFunc("POST /tasks/{id}/restore", middleware.AuthMiddleware(taskProxy.RestoreTask))
+    r.HandleFunc("GET /tasks/watch", middleware.AuthMiddleware(taskProxy.WatchTasks))
     r.HandleFunc("POST /tasks/{id}/close", middleware.AuthMiddleware(taskProxy.CloseTask))
+    r.HandleFunc("GET /tasks/{id}/history", middleware.AuthMiddleware(taskProxy.GetTaskHistory))
+    r.HandleFunc("GET /tasks/{id}/tree", middleware.AuthMiddleware(taskProxy.GetTaskTree))
+    r.HandleFunc("POST /tasks/{id}/dependencies", middleware.AuthMiddleware(taskProxy.AddDependency))
+    r.HandleFunc("DELETE /tasks/{id}/dependencies/{blocked_by}", middleware.AuthMiddleware(taskProxy.RemoveDependency))
+    r.HandleFunc("GET /tasks/{id}/comments", middleware.AuthMiddleware(taskProxy.ListComments))
+    r.HandleFunc("POST /tasks/{id}/comments", middleware.AuthMiddleware(taskProxy.AddComment))
+    r.HandleFunc("PUT /tasks/{id}/comments/{comment_id}", middleware.AuthMiddleware(taskProxy.EditComment))
+    r.HandleFunc("DELETE /tasks/{id}/comments/{comment_id}", middleware.AuthMiddleware(taskProxy.DeleteComment))
+    r.HandleFunc("GET /tasks/{id}/attachments", middleware.AuthMiddleware(taskProxy.ListAttachments))
+    r.HandleFunc("POST /tasks/{id}/attachments", middleware.AuthMiddleware(taskProxy.UploadAttachment))
+    r.HandleFunc("GET /tasks/{id}/attachments/{attachment_id}", middleware.AuthMiddleware(taskProxy.DownloadAttachment))
+    r.HandleFunc("DELETE /tasks/{id}/attachments/{attachment_id}", middleware.AuthMiddleware(taskProxy.DeleteAttachment))
+    r.HandleFunc("POST /tasks/{id}/assignees", middleware.AuthMiddleware(taskProxy.AddAssignee))
+    r.HandleFunc("DELETE /tasks/{id}/assignees/{user_id}", middleware.AuthMiddleware(taskProxy.RemoveAssignee))
+    r.HandleFunc("POST /tasks/{id}/watchers", middleware.AuthMiddleware(taskProxy.AddWatcher))
+    r.HandleFunc("DELETE /tasks/{id}/watchers/{user_id}", middleware.AuthMiddleware(taskProxy.RemoveWatcher))
+    r.HandleFunc("GET /me/assigned", middleware.AuthMiddleware(taskProxy.GetAssignedTasks))
+    r.HandleFunc("GET /me/watching", middleware.AuthMiddleware(taskProxy.GetWatchedTasks))
+    r.HandleFunc("GET /projects", middleware.AuthMiddleware(taskProxy.GetProjects))
+    r.HandleFunc("POST /projects", middleware.AuthMiddleware(taskProxy.CreateProject))
+    r.HandleFunc("GET /projects/{id}", middleware.AuthMiddleware(taskProxy.GetProject))
+    r.HandleFunc("PUT /projects/{id}", middleware.AuthMiddleware(taskProxy.UpdateProject))
+    r.HandleFunc("DELETE /projects/{id}", middleware.AuthMiddleware(taskProxy.DeleteProject))
+    r.HandleFunc("PUT /projects/{id}/members/{user_id}", middleware.AuthMiddleware(taskProxy.SetProjectMember))
+    r.HandleFunc("DELETE /projects/{id}/members/{user_id}", middleware.AuthMiddleware(taskProxy.RemoveProjectMember))
+    r.HandleFunc("GET /projects/{id}/tasks", middleware.AuthMiddleware(taskProxy.GetProjectTasks))
+    r.HandleFunc("GET /projects/{id}/tasks/search", middleware.AuthMiddleware(taskProxy.SearchProjectTasks))
+    r.HandleFunc("GET /templates", middleware.AuthMiddleware(taskProxy.GetTemplates))
+    r.HandleFunc("POST /templates", middleware.AuthMiddleware(taskProxy.CreateTemplate))
+    r.HandleFunc("GET /templates/{id}", middleware.AuthMiddleware(taskProxy.GetTemplate))
+    r.HandleFunc("PUT /templates/{id}", middleware.AuthMiddleware(taskProxy.UpdateTemplate))
+    r.HandleFunc("DELETE /templates/{id}", middleware.AuthMiddleware(taskProxy.DeleteTemplate))
+    r.HandleFunc("GET /views", middleware.AuthMiddleware(taskProxy.GetViews))
+    r.HandleFunc("POST /views", middleware.AuthMiddleware(taskProxy.CreateView))
+    r.HandleFunc("GET /views/{id}", middleware.AuthMiddleware(taskProxy.GetView))
+    r.HandleFunc("PUT /views/{id}", middleware.AuthMiddleware(taskProxy.UpdateView))
+    r.HandleFunc("DELETE /views/{id}", middleware.AuthMiddleware(taskProxy.DeleteView))
+    r.HandleFunc("GET /views/{id}/tasks", middleware.AuthMiddleware(taskProxy.GetViewTasks))
     r.HandleFunc("GET /users/{user_id}/tasks", middleware.AuthMiddleware(taskProxy.GetUserTasks))
 
     //метрики и кэш пока оставлю закомментированными
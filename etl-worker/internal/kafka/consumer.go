@@ -12,6 +12,9 @@ import (
     "etl-worker/internal/processor"
 )
 
+// batchEventType - заголовок event-type сообщения с TaskBatchEvent
+const batchEventType = "BATCH"
+
 type ETLConsumer struct {
     reader    *kafka.Reader
     processor *processor.EventProcessor
@@ -53,22 +56,48 @@ func (c *ETLConsumer) Start(ctx context.Context) {
         log.Printf("[ETL] Received message: topic=%s, partition=%d, offset=%d", 
             msg.Topic, msg.Partition, msg.Offset)
         
+        // пакетные операции task-service присылают все события одним TaskBatchEvent
+        if header(msg, "event-type") == batchEventType {
+            var batch events.TaskBatchEvent
+            if err := proto.Unmarshal(msg.Value, &batch); err != nil {
+                log.Printf("[ETL] Failed to unmarshal batch: %v", err)
+                continue
+            }
+            for _, event := range batch.Events {
+                c.process(ctx, event)
+            }
+            continue
+        }
+        
         var event events.TaskEvent
         if err := proto.Unmarshal(msg.Value, &event); err != nil {
             log.Printf("[ETL] Failed to unmarshal event: %v", err)
             continue
         }
-        
-        // Обрабатываем события
-        if err := c.processor.ProcessEvent(ctx, &event); err != nil {
-            log.Printf("[ETL] Failed to process event: %v", err)
-            // TODO: добавить retry механику
-            continue
+        c.process(ctx, &event)
+    }
+}
+
+func (c *ETLConsumer) process(ctx context.Context, event *events.TaskEvent) {
+    // Обрабатываем события
+    if err := c.processor.ProcessEvent(ctx, event); err != nil {
+        log.Printf("[ETL] Failed to process event: %v", err)
+        // TODO: добавить retry механику
+        return
+    }
+    
+    log.Printf("[ETL] Processed event: type=%s, taskID=%d, userID=%s", 
+        event.EventType, event.TaskId, event.UserId)
+}
+
+// header - значение заголовка сообщения, "" если его нет
+func header(msg kafka.Message, key string) string {
+    for _, h := range msg.Headers {
+        if h.Key == key {
+            return string(h.Value)
         }
-        
-        log.Printf("[ETL] Processed event: type=%s, taskID=%d, userID=%s", 
-            event.EventType, event.TaskId, event.UserId)
     }
+    return ""
 }
 
 func (c *ETLConsumer) Close() error {
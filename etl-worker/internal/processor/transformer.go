@@ -71,6 +71,10 @@ func (p *EventProcessor) ProcessEvent(ctx context.Context, event *events.TaskEve
         
     case "UPDATED":
         log.Printf("[ETL] Task %d updated, status: %s", event.TaskId, event.TaskStatus)
+        
+    case "PURGED":
+        // задача удалена окончательно и уже не завершится
+        p.taskStartTime.Delete(event.TaskId)
     }
     
     return nil
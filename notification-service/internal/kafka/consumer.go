@@ -13,9 +13,13 @@ import (
     "notification-service/internal/ws"
 )
 
+// batchEventType - заголовок event-type сообщения с TaskBatchEvent
+const batchEventType = "BATCH"
+
 type TaskEventConsumer struct {
     reader   *kafka.Reader
     notifier *notifiers.Manager
+    seen     *seenEvents
 }
 
 func NewTaskEventConsumer(brokers []string, topic string, groupID string, hub *ws.NotificationHub) *TaskEventConsumer {
@@ -37,6 +41,7 @@ func NewTaskEventConsumer(brokers []string, topic string, groupID string, hub *w
     return &TaskEventConsumer{
         reader:   reader,
         notifier: notifiers.NewManager(hub),
+        seen:     newSeenEvents(10000),
     }
 }
 
@@ -62,26 +67,70 @@ func (c *TaskEventConsumer) Start(ctx context.Context) {
         log.Printf("[Kafka] Received message: topic=%s, partition=%d, offset=%d", 
             msg.Topic, msg.Partition, msg.Offset)
         
+        // пакетные операции task-service присылают все события одним TaskBatchEvent
+        if header(msg, "event-type") == batchEventType {
+            var batch events.TaskBatchEvent
+            if err := proto.Unmarshal(msg.Value, &batch); err != nil {
+                log.Printf("[Kafka] Failed to unmarshal batch: %v", err)
+                continue
+            }
+            log.Printf("[Kafka] Batch: %d events", len(batch.Events))
+            for _, event := range batch.Events {
+                c.handle(ctx, event)
+            }
+            continue
+        }
+        
         var event events.TaskEvent
         if err := proto.Unmarshal(msg.Value, &event); err != nil {
             log.Printf("[Kafka] Failed to unmarshal event: %v", err)
             continue
         }
-        
-        log.Printf("[Kafka] Event: type=%s, taskID=%d, userID=%s", 
-            event.EventType, event.TaskId, event.UserId)
-        
-        switch event.EventType {
-        case "CREATED":
-            c.notifier.NotifyTaskCreated(ctx, &event)
-        case "UPDATED":
-            c.notifier.NotifyTaskUpdated(ctx, &event)
-        case "COMPLETED":
-            c.notifier.NotifyTaskCompleted(ctx, &event)
-        case "DELETED":
-            c.notifier.NotifyTaskDeleted(ctx, &event)
+        c.handle(ctx, &event)
+    }
+}
+
+func (c *TaskEventConsumer) handle(ctx context.Context, event *events.TaskEvent) {
+    log.Printf("[Kafka] Event: type=%s, taskID=%d, userID=%s", 
+        event.EventType, event.TaskId, event.UserId)
+
+    if !c.seen.add(event.EventId) {
+        log.Printf("[Kafka] Duplicate event %s, skipped", event.EventId)
+        return
+    }
+    
+    switch event.EventType {
+    case "CREATED":
+        c.notifier.NotifyTaskCreated(ctx, event)
+    case "UPDATED":
+        c.notifier.NotifyTaskUpdated(ctx, event)
+    case "COMPLETED":
+        c.notifier.NotifyTaskCompleted(ctx, event)
+    case "DELETED":
+        c.notifier.NotifyTaskDeleted(ctx, event)
+    case "RESTORED":
+        c.notifier.NotifyTaskRestored(ctx, event)
+    case "PURGED":
+        c.notifier.NotifyTaskPurged(ctx, event)
+    case "TASK_FAILED":
+        c.notifier.NotifyTaskFailed(ctx, event)
+    case "TASK_OVERDUE":
+        c.notifier.NotifyTaskOverdue(ctx, event)
+    case "COMMENT_ADDED":
+        c.notifier.NotifyCommentAdded(ctx, event)
+    case "TASK_ASSIGNED":
+        c.notifier.NotifyTaskAssigned(ctx, event)
+    }
+}
+
+// header - значение заголовка сообщения, "" если его нет
+func header(msg kafka.Message, key string) string {
+    for _, h := range msg.Headers {
+        if h.Key == key {
+            return string(h.Value)
         }
     }
+    return ""
 }
 
 func (c *TaskEventConsumer) Close() error {
@@ -0,0 +1,37 @@
+package kafka
+
+import "sync"
+
+// seenEvents помнит последние event_id: outbox в task-service доставляет
+// события at-least-once, повторы не должны приводить к повторным уведомлениям
+type seenEvents struct {
+	mu    sync.Mutex
+	limit int
+	ids   map[string]struct{}
+	order []string
+}
+
+func newSeenEvents(limit int) *seenEvents {
+	return &seenEvents{limit: limit, ids: make(map[string]struct{}, limit)}
+}
+
+// add возвращает false, если событие уже встречалось
+func (s *seenEvents) add(id string) bool {
+	if id == "" {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.ids[id]; ok {
+		return false
+	}
+	s.ids[id] = struct{}{}
+	s.order = append(s.order, id)
+	if len(s.order) > s.limit {
+		delete(s.ids, s.order[0])
+		s.order = s.order[1:]
+	}
+	return true
+}
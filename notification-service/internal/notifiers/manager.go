@@ -31,7 +31,7 @@ func (m *Manager) NotifyTaskCreated(ctx context.Context, event *events.TaskEvent
         Timestamp: event.Timestamp.AsTime().String(),
     }
     
-    m.wsHub.SendToUser(event.UserId, wsEvent)
+    m.fanOut(event, wsEvent, "")
     log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
 }
 
@@ -45,7 +45,7 @@ func (m *Manager) NotifyTaskUpdated(ctx context.Context, event *events.TaskEvent
         Timestamp: event.Timestamp.AsTime().String(),
     }
     
-    m.wsHub.SendToUser(event.UserId, wsEvent)
+    m.fanOut(event, wsEvent, "")
     log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
 }
 
@@ -59,7 +59,7 @@ func (m *Manager) NotifyTaskCompleted(ctx context.Context, event *events.TaskEve
         Timestamp: event.Timestamp.AsTime().String(),
     }
     
-    m.wsHub.SendToUser(event.UserId, wsEvent)
+    m.fanOut(event, wsEvent, "")
     log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
 }
 
@@ -73,6 +73,133 @@ func (m *Manager) NotifyTaskDeleted(ctx context.Context, event *events.TaskEvent
         Timestamp: event.Timestamp.AsTime().String(),
     }
     
-    m.wsHub.SendToUser(event.UserId, wsEvent)
+    m.fanOut(event, wsEvent, "")
     log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
-}
\ No newline at end of file
+}
+
+// NotifyTaskRestored - задачу вернули из корзины
+func (m *Manager) NotifyTaskRestored(ctx context.Context, event *events.TaskEvent) {
+    wsEvent := ws.TaskStatusEvent{
+        Type:      "task_restored",
+        TaskID:    int(event.TaskId),
+        Text:      event.TaskText,
+        Status:    event.TaskStatus,
+        UserID:    event.UserId,
+        Timestamp: event.Timestamp.AsTime().String(),
+    }
+    
+    m.fanOut(event, wsEvent, "")
+    log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
+}
+
+// NotifyTaskPurged - задача удалена из корзины окончательно
+func (m *Manager) NotifyTaskPurged(ctx context.Context, event *events.TaskEvent) {
+    wsEvent := ws.TaskStatusEvent{
+        Type:      "task_purged",
+        TaskID:    int(event.TaskId),
+        Text:      event.TaskText,
+        Status:    event.TaskStatus,
+        UserID:    event.UserId,
+        Timestamp: event.Timestamp.AsTime().String(),
+    }
+    
+    m.fanOut(event, wsEvent, "")
+    log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
+}
+
+func (m *Manager) NotifyTaskFailed(ctx context.Context, event *events.TaskEvent) {
+    wsEvent := ws.TaskStatusEvent{
+        Type:      "task_failed",
+        TaskID:    int(event.TaskId),
+        Text:      event.TaskText,
+        Status:    event.TaskStatus,
+        UserID:    event.UserId,
+        Timestamp: event.Timestamp.AsTime().String(),
+    }
+    
+    if r := event.GetFailureReason(); r != nil {
+        wsEvent.Reason = &ws.FailureReason{
+            Code:      r.GetCode(),
+            Validator: r.GetValidator(),
+            Message:   r.GetMessage(),
+            Details:   r.GetDetails(),
+        }
+    }
+    
+    m.fanOut(event, wsEvent, "")
+    log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
+}
+
+// NotifyTaskOverdue - у задачи прошёл срок, state machine подняла приоритет
+func (m *Manager) NotifyTaskOverdue(ctx context.Context, event *events.TaskEvent) {
+    wsEvent := ws.TaskStatusEvent{
+        Type:      "task_overdue",
+        TaskID:    int(event.TaskId),
+        Text:      event.TaskText,
+        Status:    event.TaskStatus,
+        UserID:    event.UserId,
+        Timestamp: event.Timestamp.AsTime().String(),
+        Priority:  event.GetPriority(),
+    }
+    if event.DueAt != nil {
+        wsEvent.DueAt = event.DueAt.AsTime().String()
+    }
+    
+    m.fanOut(event, wsEvent, "")
+    log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
+}
+
+// NotifyCommentAdded - новый комментарий к задаче, уходит всем, кроме автора
+func (m *Manager) NotifyCommentAdded(ctx context.Context, event *events.TaskEvent) {
+    comment := event.GetComment()
+    if comment == nil {
+        return
+    }
+    wsEvent := ws.TaskStatusEvent{
+        Type:      "comment_added",
+        TaskID:    int(event.TaskId),
+        Text:      event.TaskText,
+        Status:    event.TaskStatus,
+        UserID:    event.UserId,
+        Timestamp: event.Timestamp.AsTime().String(),
+        Comment: &ws.Comment{
+            ID:     int(comment.GetId()),
+            UserID: comment.GetUserId(),
+            Body:   comment.GetBody(),
+        },
+    }
+    
+    m.fanOut(event, wsEvent, comment.GetUserId())
+    log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
+}
+
+// NotifyTaskAssigned - пользователя назначили исполнителем
+func (m *Manager) NotifyTaskAssigned(ctx context.Context, event *events.TaskEvent) {
+    wsEvent := ws.TaskStatusEvent{
+        Type:      "task_assigned",
+        TaskID:    int(event.TaskId),
+        Text:      event.TaskText,
+        Status:    event.TaskStatus,
+        UserID:    event.UserId,
+        Timestamp: event.Timestamp.AsTime().String(),
+        Assignees: event.GetAssignees(),
+    }
+    
+    m.fanOut(event, wsEvent, "")
+    log.Printf("Notification sent for task %d: %s", event.TaskId, event.EventType)
+}
+
+// fanOut отправляет событие владельцу задачи, исполнителям и наблюдателям (каждому один раз),
+// кроме skipUserID - того, кто сам вызвал изменение
+func (m *Manager) fanOut(event *events.TaskEvent, wsEvent ws.TaskStatusEvent, skipUserID string) {
+    sent := map[string]bool{"": true, skipUserID: true}
+    recipients := append([]string{event.GetUserId()}, event.GetAssignees()...)
+    recipients = append(recipients, event.GetWatchers()...)
+    for _, userID := range recipients {
+        if sent[userID] {
+            continue
+        }
+        sent[userID] = true
+        m.wsHub.SendToUser(userID, wsEvent)
+    }
+}
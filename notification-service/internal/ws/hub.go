@@ -17,6 +17,26 @@ type TaskStatusEvent struct {
 	Status    string `json:"status"`
 	UserID    string `json:"user_id"` 
 	Timestamp string `json:"timestamp"`
+	Reason    *FailureReason `json:"reason,omitempty"` // только для task_failed
+	Priority  string `json:"priority,omitempty"`
+	DueAt     string `json:"due_at,omitempty"`
+	Comment   *Comment `json:"comment,omitempty"` // только для comment_added
+	Assignees []string `json:"assignees,omitempty"` // только для task_assigned
+}
+
+// Comment - новый комментарий к задаче
+type Comment struct {
+	ID     int    `json:"id"`
+	UserID string `json:"user_id"` // автор
+	Body   string `json:"body"`
+}
+
+// FailureReason - почему задача не прошла валидацию
+type FailureReason struct {
+	Code      string            `json:"code"`
+	Validator string            `json:"validator,omitempty"`
+	Message   string            `json:"message"`
+	Details   map[string]string `json:"details,omitempty"`
 }
 
 type NotificationHub struct {
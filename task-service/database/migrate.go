@@ -0,0 +1,274 @@
+package database
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// migrateLockKey - advisory lock: реплики, запущенные одновременно, применяют миграции по очереди
+const migrateLockKey int64 = 0x7461736b6d67 // "taskmg"
+
+// ErrChecksumMismatch - применённую миграцию отредактировали после применения
+var ErrChecksumMismatch = errors.New("migration checksum mismatch")
+
+// ErrIrreversible - у миграции нет файла отката
+var ErrIrreversible = errors.New("migration has no down file")
+
+var migrationFile = regexp.MustCompile(`^(\d+)_(\w+?)(\.down)?\.sql$`)
+
+// Migration - версия схемы: SQL применения, отката и контрольная сумма применения
+type Migration struct {
+	Version  int
+	Name     string
+	Up       string
+	Down     string // пусто - откатить нельзя
+	Checksum string // sha256 от Up, hex
+}
+
+// MigrationStatus - состояние миграции в базе
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time // nil - не применена
+	Modified  bool       // файл изменился после применения
+	Missing   bool       // применена, но в бинарнике такой нет (база новее кода)
+}
+
+// LoadMigrations читает миграции из fsys (NNN_name.sql и NNN_name.down.sql) по возрастанию версий
+func LoadMigrations(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		m := migrationFile.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || m == nil {
+			continue
+		}
+		version, _ := strconv.Atoi(m[1])
+		data, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		}
+		if mig.Name != m[2] {
+			return nil, fmt.Errorf("migration %03d: names %q and %q differ", version, mig.Name, m[2])
+		}
+		if m[3] != "" {
+			mig.Down = string(data)
+			continue
+		}
+		if mig.Up != "" {
+			return nil, fmt.Errorf("migration %03d: duplicate file %s", version, entry.Name())
+		}
+		mig.Up = string(data)
+		sum := sha256.Sum256(data)
+		mig.Checksum = hex.EncodeToString(sum[:])
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("migration %03d_%s: down file without up", mig.Version, mig.Name)
+		}
+		migrations = append(migrations, *mig)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrator применяет и откатывает миграции; применённые записаны в schema_migrations
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, fsys fs.FS) (*Migrator, error) {
+	migrations, err := LoadMigrations(fsys)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// appliedMigration - строка schema_migrations
+type appliedMigration struct {
+	name      string
+	checksum  string
+	appliedAt time.Time
+}
+
+// Up применяет все неприменённые миграции, каждую в своей транзакции.
+// Если применённую миграцию отредактировали - ErrChecksumMismatch, ничего не применяется
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.locked(ctx, func(conn *sql.Conn, applied map[int]appliedMigration) error {
+		for _, mig := range m.migrations {
+			if a, ok := applied[mig.Version]; ok && a.checksum != mig.Checksum {
+				return fmt.Errorf("%w: %03d_%s", ErrChecksumMismatch, mig.Version, mig.Name)
+			}
+		}
+		for _, mig := range m.migrations {
+			if _, ok := applied[mig.Version]; ok {
+				continue
+			}
+			err := m.exec(ctx, conn, mig.Up, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx,
+					`INSERT INTO schema_migrations (version, name, checksum) VALUES ($1, $2, $3)`,
+					mig.Version, mig.Name, mig.Checksum)
+				return err
+			})
+			if err != nil {
+				return fmt.Errorf("apply %03d_%s: %w", mig.Version, mig.Name, err)
+			}
+			done = append(done, mig)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down откатывает последнюю применённую миграцию; nil - откатывать нечего
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	var rolledBack *Migration
+	err := m.locked(ctx, func(conn *sql.Conn, applied map[int]appliedMigration) error {
+		last := -1
+		for version := range applied {
+			if version > last {
+				last = version
+			}
+		}
+		if last < 0 {
+			return nil
+		}
+
+		var mig *Migration
+		for i := range m.migrations {
+			if m.migrations[i].Version == last {
+				mig = &m.migrations[i]
+			}
+		}
+		if mig == nil {
+			return fmt.Errorf("migration %03d_%s is not known to this build", last, applied[last].name)
+		}
+		if mig.Down == "" {
+			return fmt.Errorf("%w: %03d_%s", ErrIrreversible, mig.Version, mig.Name)
+		}
+
+		err := m.exec(ctx, conn, mig.Down, func(tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations WHERE version = $1`, mig.Version)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("roll back %03d_%s: %w", mig.Version, mig.Name, err)
+		}
+		rolledBack = mig
+		return nil
+	})
+	return rolledBack, err
+}
+
+// Status - все известные и применённые миграции по возрастанию версий
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+	err := m.locked(ctx, func(conn *sql.Conn, applied map[int]appliedMigration) error {
+		for _, mig := range m.migrations {
+			st := MigrationStatus{Migration: mig}
+			if a, ok := applied[mig.Version]; ok {
+				appliedAt := a.appliedAt
+				st.AppliedAt = &appliedAt
+				st.Modified = a.checksum != mig.Checksum
+				delete(applied, mig.Version)
+			}
+			statuses = append(statuses, st)
+		}
+		for version, a := range applied {
+			appliedAt := a.appliedAt
+			statuses = append(statuses, MigrationStatus{
+				Migration: Migration{Version: version, Name: a.name, Checksum: a.checksum},
+				AppliedAt: &appliedAt,
+				Missing:   true,
+			})
+		}
+		return nil
+	})
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Version < statuses[j].Version })
+	return statuses, err
+}
+
+// locked выполняет fn на отдельном соединении под advisory lock, передавая применённые миграции
+func (m *Migrator) locked(ctx context.Context, fn func(conn *sql.Conn, applied map[int]appliedMigration) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrateLockKey); err != nil {
+		return fmt.Errorf("migration lock: %w", err)
+	}
+	defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrateLockKey)
+
+	_, err = conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INT PRIMARY KEY,
+		name       TEXT NOT NULL,
+		checksum   TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	)`)
+	if err != nil {
+		return fmt.Errorf("create schema_migrations: %w", err)
+	}
+
+	rows, err := conn.QueryContext(ctx, `SELECT version, name, checksum, applied_at FROM schema_migrations`)
+	if err != nil {
+		return err
+	}
+	applied := make(map[int]appliedMigration)
+	for rows.Next() {
+		var version int
+		var a appliedMigration
+		if err := rows.Scan(&version, &a.name, &a.checksum, &a.appliedAt); err != nil {
+			rows.Close()
+			return err
+		}
+		applied[version] = a
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return fn(conn, applied)
+}
+
+// exec выполняет SQL миграции и запись в schema_migrations одной транзакцией
+func (m *Migrator) exec(ctx context.Context, conn *sql.Conn, query string, record func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, query); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := record(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
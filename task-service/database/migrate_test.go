@@ -0,0 +1,61 @@
+package database
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"task-service/migrations"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"002_add_b.sql":      {Data: []byte("ALTER TABLE b ADD COLUMN c INT;")},
+		"001_init.sql":       {Data: []byte("CREATE TABLE b (id INT);")},
+		"002_add_b.down.sql": {Data: []byte("ALTER TABLE b DROP COLUMN c;")},
+		"README.md":          {Data: []byte("not a migration")},
+	}
+	got, err := LoadMigrations(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Version != 1 || got[1].Version != 2 {
+		t.Fatalf("migrations = %+v", got)
+	}
+	if got[1].Name != "add_b" || got[1].Down == "" || got[0].Down != "" {
+		t.Errorf("migration 2 = %+v", got[1])
+	}
+	if got[0].Checksum == "" || got[0].Checksum == got[1].Checksum {
+		t.Errorf("checksums %q, %q", got[0].Checksum, got[1].Checksum)
+	}
+}
+
+func TestLoadMigrations_Invalid(t *testing.T) {
+	cases := map[string]fstest.MapFS{
+		"down without up": {"003_x.down.sql": {Data: []byte("SELECT 1;")}},
+		"name mismatch": {
+			"003_x.sql":      {Data: []byte("SELECT 1;")},
+			"003_y.down.sql": {Data: []byte("SELECT 1;")},
+		},
+	}
+	for name, fsys := range cases {
+		if _, err := LoadMigrations(fsys); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+// встроенные миграции нумеруются подряд с 001, у каждой, кроме исходной схемы, есть откат
+func TestEmbeddedMigrations(t *testing.T) {
+	got, err := LoadMigrations(migrations.Files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, m := range got {
+		if m.Version != i+1 {
+			t.Fatalf("migration #%d has version %03d, want %03d", i, m.Version, i+1)
+		}
+		if m.Version > 1 && m.Down == "" {
+			t.Errorf("%03d_%s has no down file", m.Version, m.Name)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// ErrNoTx - в контексте нет открытой транзакции
+var ErrNoTx = errors.New("no transaction in context")
+
+// DBTX - общее у *sql.DB и *sql.Tx, чтобы репозитории работали и внутри транзакции
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type txKey struct{}
+
+// txState - открытая транзакция и то, что нужно сделать после её коммита
+type txState struct {
+	tx          *sql.Tx
+	afterCommit []func()
+	savepoints  int // счётчик для имён SAVEPOINT
+}
+
+// Conn возвращает транзакцию из контекста или сам пул
+func Conn(ctx context.Context, db *sql.DB) DBTX {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+	return db
+}
+
+// InTx - в контексте открыта транзакция
+func InTx(ctx context.Context) bool {
+	_, ok := ctx.Value(txKey{}).(*txState)
+	return ok
+}
+
+// WithinTx выполняет fn в транзакции; вложенные вызовы переиспользуют уже открытую
+func WithinTx(ctx context.Context, db *sql.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+
+	state := &txState{tx: tx}
+	if err := fn(context.WithValue(ctx, txKey{}, state)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	for _, f := range state.afterCommit {
+		f()
+	}
+	return nil
+}
+
+// AfterCommit откладывает fn до коммита транзакции из контекста (при откате fn не вызывается).
+// Вне транзакции fn выполняется сразу
+func AfterCommit(ctx context.Context, fn func()) {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		state.afterCommit = append(state.afterCommit, fn)
+		return
+	}
+	fn()
+}
+
+// WithinSavepoint выполняет fn под SAVEPOINT в транзакции из контекста: при ошибке fn
+// откатывается только сделанное в fn (вместе с его AfterCommit), транзакция продолжается
+func WithinSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	state, ok := ctx.Value(txKey{}).(*txState)
+	if !ok {
+		return ErrNoTx
+	}
+
+	state.savepoints++
+	name := fmt.Sprintf("sp_%d", state.savepoints)
+	if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("savepoint: %w", err)
+	}
+
+	pending := len(state.afterCommit)
+	if err := fn(ctx); err != nil {
+		if _, rbErr := state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("rollback to savepoint: %w (after %v)", rbErr, err)
+		}
+		state.afterCommit = state.afterCommit[:pending]
+		return err
+	}
+	if _, err := state.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("release savepoint: %w", err)
+	}
+	return nil
+}
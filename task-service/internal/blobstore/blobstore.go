@@ -0,0 +1,21 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound - блоба с таким ключом нет
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore - хранилище содержимого вложений. Ключи вида "<task_id>/<random>",
+// метаданные (имя, размер, checksum) лежат в БД
+type BlobStore interface {
+	// Put записывает весь r под ключом key и возвращает число байт.
+	// При ошибке частично записанные данные не остаются
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete не считает ошибкой отсутствие блоба
+	Delete(ctx context.Context, key string) error
+}
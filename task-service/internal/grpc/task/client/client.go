@@ -67,11 +67,12 @@ func (c *TaskClient) ListTasks(ctx context.Context, userID, status string, page,
 	return resp.GetTasks(), resp.GetTotal(), nil
 }
 
-func (c *TaskClient) UpdateTask(ctx context.Context, id int32, text, status string) (*pb.Task, error) {
+func (c *TaskClient) UpdateTask(ctx context.Context, id int32, text, status string, expectedVersion int32) (*pb.Task, error) {
 	resp, err := c.client.UpdateTask(ctx, &pb.UpdateTaskRequest{
-		Id:     id,
-		Text:   text,
-		Status: status,
+		Id:              id,
+		Text:            text,
+		Status:          status,
+		ExpectedVersion: expectedVersion,
 	})
 	if err != nil {
 		return nil, err
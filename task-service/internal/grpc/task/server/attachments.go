@@ -0,0 +1,270 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"task-service/internal/blobstore"
+	pb "task-service/internal/grpc/task/pb"
+	"task-service/internal/models"
+	"task-service/internal/repositories"
+)
+
+// размер куска при отдаче вложения
+const attachmentChunkSize = 64 * 1024
+
+// Attachments - зависимости RPC вложений
+type Attachments struct {
+	Repo  repositories.AttachmentRepository
+	Blobs blobstore.BlobStore
+	Quota int64 // байт на пользователя, 0 - без ограничения
+}
+
+// UploadAttachment принимает AttachmentInfo и содержимое файла кусками.
+// Файл пишется в BlobStore сразу из стрима, checksum считается по дороге
+func (s *TaskServer) UploadAttachment(stream pb.TaskService_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "first message must be attachment info")
+	}
+	name := path.Base(strings.ReplaceAll(strings.TrimSpace(info.GetName()), "\\", "/"))
+	if name == "" || name == "." || name == "/" {
+		return status.Error(codes.InvalidArgument, "attachment name is required")
+	}
+	contentType := info.GetContentType()
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	task, err := s.attachmentTask(ctx, info.GetUserId(), info.GetTaskId(), accessEdit)
+	if err != nil {
+		return err
+	}
+
+	// остаток квоты ограничивает чтение стрима: больше remaining+1 байт не пишем
+	quota := s.attachments.Quota
+	var remaining int64
+	if quota > 0 {
+		used, err := s.attachments.Repo.UsedBytes(ctx, info.GetUserId())
+		if err != nil {
+			return err
+		}
+		remaining = quota - used
+		if remaining <= 0 {
+			return quotaError(quota)
+		}
+	}
+
+	key, err := blobKey(task.ID)
+	if err != nil {
+		return err
+	}
+	var content io.Reader = &uploadReader{stream: stream}
+	if quota > 0 {
+		content = io.LimitReader(content, remaining+1)
+	}
+	hash := sha256.New()
+	size, err := s.attachments.Blobs.Put(ctx, key, io.TeeReader(content, hash))
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return fmt.Errorf("store attachment: %w", err)
+	}
+
+	attachment := &models.Attachment{
+		TaskID:      task.ID,
+		UserID:      info.GetUserId(),
+		Name:        name,
+		ContentType: contentType,
+		Size:        size,
+		Checksum:    hex.EncodeToString(hash.Sum(nil)),
+		StorageKey:  key,
+	}
+	if quota > 0 && size > remaining {
+		err = repositories.ErrQuotaExceeded
+	} else {
+		err = s.attachments.Repo.Create(ctx, attachment, quota)
+	}
+	if err != nil {
+		s.deleteBlob(key)
+		if errors.Is(err, repositories.ErrQuotaExceeded) {
+			return quotaError(quota)
+		}
+		return err
+	}
+
+	return stream.SendAndClose(&pb.UploadAttachmentResponse{Attachment: attachmentToProto(attachment)})
+}
+
+func (s *TaskServer) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	task, err := s.attachmentTask(ctx, req.GetUserId(), req.GetTaskId(), accessView)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := s.attachments.Repo.ListByTask(ctx, task.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var protoAttachments []*pb.Attachment
+	for i := range attachments {
+		protoAttachments = append(protoAttachments, attachmentToProto(&attachments[i]))
+	}
+	return &pb.ListAttachmentsResponse{Attachments: protoAttachments}, nil
+}
+
+// DownloadAttachment отдаёт метаданные, затем содержимое кусками
+func (s *TaskServer) DownloadAttachment(req *pb.DownloadAttachmentRequest, stream pb.TaskService_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+	if _, err := s.attachmentTask(ctx, req.GetUserId(), req.GetTaskId(), accessView); err != nil {
+		return err
+	}
+	attachment, err := s.taskAttachment(ctx, req.GetTaskId(), req.GetAttachmentId())
+	if err != nil {
+		return err
+	}
+
+	blob, err := s.attachments.Blobs.Get(ctx, attachment.StorageKey)
+	if errors.Is(err, blobstore.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "attachment %d content is missing", attachment.ID)
+	}
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	if err := stream.Send(&pb.DownloadAttachmentResponse{
+		Data: &pb.DownloadAttachmentResponse_Attachment{Attachment: attachmentToProto(attachment)},
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, attachmentChunkSize)
+	for {
+		n, err := blob.Read(buf)
+		if n > 0 {
+			chunk := &pb.DownloadAttachmentResponse{Data: &pb.DownloadAttachmentResponse_Chunk{Chunk: buf[:n]}}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *TaskServer) DeleteAttachment(ctx context.Context, req *pb.DeleteAttachmentRequest) (*pb.DeleteAttachmentResponse, error) {
+	if _, err := s.attachmentTask(ctx, req.GetUserId(), req.GetTaskId(), accessEdit); err != nil {
+		return nil, err
+	}
+	attachment, err := s.taskAttachment(ctx, req.GetTaskId(), req.GetAttachmentId())
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.attachments.Repo.Delete(ctx, attachment.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "attachment %d not found", attachment.ID)
+	}
+	s.deleteBlob(attachment.StorageKey)
+	return &pb.DeleteAttachmentResponse{Success: true}, nil
+}
+
+// attachmentTask - задача вложений, если у userID есть доступ need (правила те же, что в пакетных RPC):
+// без доступа - NotFound, как и несуществующая задача; прочие ошибки БД возвращаются как есть
+func (s *TaskServer) attachmentTask(ctx context.Context, userID string, taskID int32, need access) (*models.Task, error) {
+	if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	return s.newBatchAccess(userID).task(ctx, int(taskID), need)
+}
+
+// taskAttachment возвращает вложение, если оно прикреплено к задаче taskID
+func (s *TaskServer) taskAttachment(ctx context.Context, taskID, attachmentID int32) (*models.Attachment, error) {
+	attachment, err := s.attachments.Repo.GetByID(ctx, int(attachmentID))
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && attachment.TaskID != int(taskID)) {
+		return nil, status.Errorf(codes.NotFound, "attachment %d not found", attachmentID)
+	}
+	return attachment, err
+}
+
+func (s *TaskServer) deleteBlob(key string) {
+	if err := s.attachments.Blobs.Delete(context.Background(), key); err != nil {
+		log.Printf("[attachments] failed to delete blob %s: %v", key, err)
+	}
+}
+
+// blobKey - "<task_id>/<random>": имя файла от клиента в ключ не попадает
+func blobKey(taskID int) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d/%s", taskID, hex.EncodeToString(b)), nil
+}
+
+func quotaError(quota int64) error {
+	return status.Errorf(codes.ResourceExhausted, "attachment quota of %d bytes exceeded", quota)
+}
+
+// uploadReader читает содержимое файла из стрима UploadAttachment
+type uploadReader struct {
+	stream pb.TaskService_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		if msg.GetInfo() != nil {
+			return 0, status.Error(codes.InvalidArgument, "attachment info must be sent once")
+		}
+		r.buf = msg.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func attachmentToProto(a *models.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:          int32(a.ID),
+		TaskId:      int32(a.TaskID),
+		UserId:      a.UserID,
+		Name:        a.Name,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Checksum:    a.Checksum,
+		CreatedAt:   timestamppb.New(a.CreatedAt),
+	}
+}
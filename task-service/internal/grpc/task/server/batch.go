@@ -0,0 +1,230 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"task-service/database"
+	pb "task-service/internal/grpc/task/pb"
+	"task-service/internal/models"
+	"task-service/internal/repositories"
+)
+
+// maxBatchItems - сколько элементов можно передать в одном пакетном запросе
+const maxBatchItems = 500
+
+// BatchCreateTasks создаёт задачи пользователя одной транзакцией
+func (s *TaskServer) BatchCreateTasks(ctx context.Context, req *pb.BatchCreateTasksRequest) (*pb.BatchTasksResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	acl := s.newBatchAccess(req.GetUserId())
+
+	return s.runBatch(ctx, len(req.GetItems()), func(ctx context.Context, i int) (*models.Task, error) {
+		item := req.GetItems()[i]
+		item.UserId = req.GetUserId()
+		// задачи в проекте создают его редакторы и владельцы
+		if projectID := int(item.GetProjectId()); projectID > 0 {
+			level, err := acl.project(ctx, projectID)
+			if err != nil {
+				return nil, err
+			}
+			if level < accessEdit {
+				return nil, status.Errorf(codes.NotFound, "project %d not found", projectID)
+			}
+		}
+		return s.createTask(ctx, item)
+	})
+}
+
+// BatchUpdateTasks меняет задачи одной транзакцией; как и в UpdateTask,
+// у каждого элемента обязателен expected_version
+func (s *TaskServer) BatchUpdateTasks(ctx context.Context, req *pb.BatchUpdateTasksRequest) (*pb.BatchTasksResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	acl := s.newBatchAccess(req.GetUserId())
+
+	return s.runBatch(ctx, len(req.GetItems()), func(ctx context.Context, i int) (*models.Task, error) {
+		item := req.GetItems()[i]
+		item.UserId = req.GetUserId()
+		if err := validateUpdate(item); err != nil {
+			return nil, err
+		}
+		task, err := acl.task(ctx, int(item.GetId()), accessEdit)
+		if err != nil {
+			return nil, err
+		}
+		return s.updateTask(ctx, task, item)
+	})
+}
+
+// BatchDeleteTasks переносит задачи в корзину одной транзакцией
+func (s *TaskServer) BatchDeleteTasks(ctx context.Context, req *pb.BatchDeleteTasksRequest) (*pb.BatchTasksResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	acl := s.newBatchAccess(req.GetUserId())
+	ctx = repositories.WithActor(ctx, models.Actor{Type: models.ActorUser, ID: req.GetUserId()}, "")
+
+	return s.runBatch(ctx, len(req.GetIds()), func(ctx context.Context, i int) (*models.Task, error) {
+		task, err := acl.task(ctx, int(req.GetIds()[i]), accessOwner)
+		if err != nil {
+			return nil, err
+		}
+		return task, s.deleteTask(ctx, task)
+	})
+}
+
+// runBatch выполняет n элементов в одной транзакции, каждый - под своим SAVEPOINT:
+// ошибка элемента откатывает только его и попадает в его результат. События
+// успешных элементов сохраняются в outbox одним TaskBatchEvent
+func (s *TaskServer) runBatch(ctx context.Context, n int, item func(ctx context.Context, i int) (*models.Task, error)) (*pb.BatchTasksResponse, error) {
+	if n == 0 {
+		return nil, status.Error(codes.InvalidArgument, "batch is empty")
+	}
+	if n > maxBatchItems {
+		return nil, status.Errorf(codes.InvalidArgument, "batch is too large: %d items, max %d", n, maxBatchItems)
+	}
+
+	var resp *pb.BatchTasksResponse
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		resp = &pb.BatchTasksResponse{}
+		return s.outbox.Batch(ctx, func(ctx context.Context) error {
+			for i := 0; i < n; i++ {
+				var task *models.Task
+				err := database.WithinSavepoint(ctx, func(ctx context.Context) error {
+					// события элемента попадут в пакет, только если элемент удался
+					return s.outbox.Batch(ctx, func(ctx context.Context) error {
+						var err error
+						task, err = item(ctx, i)
+						return err
+					})
+				})
+
+				result := &pb.BatchItemResult{Index: int32(i)}
+				if err != nil {
+					code, msg := itemError(err)
+					result.Code, result.Error = int32(code), msg
+					resp.Failed++
+				} else {
+					result.Task = taskToProto(task)
+					resp.Succeeded++
+				}
+				resp.Results = append(resp.Results, result)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// itemError - код и сообщение ошибки элемента пакета
+func itemError(err error) (codes.Code, string) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return codes.NotFound, "task not found"
+	case errors.Is(err, repositories.ErrVersionConflict):
+		return codes.Aborted, err.Error()
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Code(), st.Message()
+	}
+	return codes.Internal, err.Error()
+}
+
+// access - уровень доступа к задаче, те же правила, что в api-gateway
+type access int
+
+const (
+	accessNone  access = iota
+	accessView         // наблюдатель задачи, viewer проекта
+	accessEdit         // исполнитель задачи, editor проекта
+	accessOwner        // владелец задачи, owner проекта
+)
+
+// batchAccess проверяет доступ пользователя к элементам пакета (и к задаче во вложениях).
+// Пакет приходит одним запросом, поэтому проверки делает task-service, а не api-gateway;
+// роли в проектах запоминаются на время пакета
+type batchAccess struct {
+	s      *TaskServer
+	userID string
+	roles  map[int]access
+}
+
+func (s *TaskServer) newBatchAccess(userID string) *batchAccess {
+	return &batchAccess{s: s, userID: userID, roles: make(map[int]access)}
+}
+
+// task загружает задачу и проверяет, что доступ не меньше need;
+// задача без доступа не отличается от несуществующей
+func (a *batchAccess) task(ctx context.Context, id int, need access) (*models.Task, error) {
+	task, err := a.s.repo.GetByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "task %d not found", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	level := accessNone
+	switch {
+	case task.UserID == a.userID:
+		level = accessOwner
+	case containsID(task.Assignees, a.userID):
+		level = accessEdit
+	case containsID(task.Watchers, a.userID):
+		level = accessView
+	}
+	if task.ProjectID != nil && level < accessOwner {
+		role, err := a.project(ctx, *task.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if role > level {
+			level = role
+		}
+	}
+	if level < need {
+		return nil, status.Errorf(codes.NotFound, "task %d not found", id)
+	}
+	return task, nil
+}
+
+func (a *batchAccess) project(ctx context.Context, projectID int) (access, error) {
+	if level, ok := a.roles[projectID]; ok {
+		return level, nil
+	}
+	role, err := a.s.projects.MemberRole(ctx, projectID, a.userID)
+	if err != nil {
+		return accessNone, err
+	}
+
+	level := accessNone
+	switch role {
+	case models.ProjectOwner:
+		level = accessOwner
+	case models.ProjectEditor:
+		level = accessEdit
+	case models.ProjectViewer:
+		level = accessView
+	}
+	a.roles[projectID] = level
+	return level, nil
+}
+
+func containsID(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
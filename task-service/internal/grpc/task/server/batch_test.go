@@ -0,0 +1,138 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+
+	"task-service/database"
+	pb "task-service/internal/grpc/task/pb"
+	"task-service/internal/kafka"
+	"task-service/internal/models"
+	"task-service/internal/outbox"
+	"task-service/internal/repositories"
+	"task-service/internal/testdb"
+	"task-service/internal/validation"
+	"task-service/internal/workflow"
+)
+
+// элемент 1 создаёт задачу, а потом падает на SQL-ошибке, которая ломает транзакцию:
+// откатиться должен только его SAVEPOINT, элементы 0 и 2 - закоммититься,
+// а их события - уйти одним TaskBatchEvent
+func TestRunBatch_FailedItemRollsBackOnlyItself(t *testing.T) {
+	db := testdb.Open(t)
+	ctx := context.Background()
+	userID := testdb.UserID(t, db)
+
+	def, err := workflow.Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := outbox.NewStore(db)
+	s := &TaskServer{
+		repo:   repositories.NewTaskRepository(db, nil, def.NonTerminalStates()),
+		outbox: store,
+		engine: workflow.NewEngine(def, validation.NewRegistry()),
+	}
+
+	var lastOutboxID int64
+	if err := db.QueryRow(`SELECT COALESCE(MAX(id), 0) FROM outbox`).Scan(&lastOutboxID); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := s.runBatch(ctx, 3, func(ctx context.Context, i int) (*models.Task, error) {
+		task, err := s.createTask(ctx, &pb.CreateTaskRequest{Text: fmt.Sprintf("item %d", i), UserId: userID})
+		if err != nil || i != 1 {
+			return task, err
+		}
+		_, err = database.Conn(ctx, db).ExecContext(ctx, `SELECT 1 / 0`)
+		return nil, err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Succeeded != 2 || resp.Failed != 1 || len(resp.Results) != 3 {
+		t.Fatalf("expected 2 succeeded and 1 failed, got %+v", resp)
+	}
+	if r := resp.Results[1]; r.Task != nil || codes.Code(r.Code) != codes.Internal || r.Error == "" {
+		t.Errorf("expected item 1 to fail with an internal error, got %+v", r)
+	}
+
+	rows, err := db.Query(`SELECT id, text FROM "Tasks" WHERE user_id = $1 ORDER BY id`, userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var ids []int32
+	var texts []string
+	for rows.Next() {
+		var id int32
+		var text string
+		if err := rows.Scan(&id, &text); err != nil {
+			t.Fatal(err)
+		}
+		ids, texts = append(ids, id), append(texts, text)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(texts) != 2 || texts[0] != "item 0" || texts[1] != "item 2" {
+		t.Fatalf("expected tasks of items 0 and 2, got %v", texts)
+	}
+
+	// события - одной пакетной записью outbox, без отдельных записей по задачам
+	var single int
+	err = db.QueryRow(`SELECT COUNT(*) FROM outbox WHERE id > $1 AND task_id = ANY($2)`,
+		lastOutboxID, pq.Array(ids)).Scan(&single)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if single != 0 {
+		t.Errorf("expected no per-task outbox records, got %d", single)
+	}
+
+	var batches [][]int32
+	batchRows, err := db.Query(`SELECT id FROM outbox WHERE id > $1 AND event_type = $2 ORDER BY id`, lastOutboxID, kafka.BatchEventType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer batchRows.Close()
+	for batchRows.Next() {
+		var id int64
+		if err := batchRows.Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		evs, err := store.Events(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// базу делят другие тесты: чужие пакеты пропускаем
+		if len(evs) == 0 || evs[0].UserId != userID {
+			continue
+		}
+		t.Cleanup(func() { db.Exec(`DELETE FROM outbox WHERE id = $1`, id) })
+		var taskIDs []int32
+		for _, e := range evs {
+			if e.EventType != "CREATED" {
+				t.Errorf("unexpected event %s in the batch", e.EventType)
+			}
+			taskIDs = append(taskIDs, e.TaskId)
+		}
+		sort.Slice(taskIDs, func(i, j int) bool { return taskIDs[i] < taskIDs[j] })
+		batches = append(batches, taskIDs)
+	}
+	if err := batchRows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 1 {
+		t.Fatalf("expected exactly one TaskBatchEvent, got %d", len(batches))
+	}
+	if got := batches[0]; len(got) != 2 || got[0] != ids[0] || got[1] != ids[1] {
+		t.Errorf("expected events for tasks %v, got %v", ids, got)
+	}
+}
@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "task-service/internal/grpc/task/pb"
+	"task-service/internal/kafka"
+	"task-service/internal/models"
+	events "task-service/proto/events"
+)
+
+const maxCommentLength = 4000
+
+// AddComment сохраняет комментарий и событие COMMENT_ADDED одной транзакцией
+func (s *TaskServer) AddComment(ctx context.Context, req *pb.AddCommentRequest) (*pb.AddCommentResponse, error) {
+	body, err := commentBody(req.GetBody())
+	if err != nil {
+		return nil, err
+	}
+	task, err := s.repo.GetByID(ctx, int(req.GetTaskId()))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "task %d not found", req.GetTaskId())
+	}
+
+	comment := &models.Comment{
+		TaskID: task.ID,
+		UserID: req.GetUserId(),
+		Body:   body,
+	}
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.comments.Create(ctx, comment); err != nil {
+			return err
+		}
+		return s.outbox.Enqueue(ctx, commentEvent(task, comment))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddCommentResponse{Comment: commentToProto(comment)}, nil
+}
+
+func (s *TaskServer) ListComments(ctx context.Context, req *pb.ListCommentsRequest) (*pb.ListCommentsResponse, error) {
+	comments, total, err := s.comments.List(ctx, int(req.GetTaskId()), int(req.GetPage()), int(req.GetPageSize()))
+	if err != nil {
+		return nil, err
+	}
+
+	var protoComments []*pb.Comment
+	for i := range comments {
+		protoComments = append(protoComments, commentToProto(&comments[i]))
+	}
+
+	return &pb.ListCommentsResponse{
+		Comments: protoComments,
+		Total:    int32(total),
+		Page:     req.GetPage(),
+		PageSize: req.GetPageSize(),
+	}, nil
+}
+
+func (s *TaskServer) EditComment(ctx context.Context, req *pb.EditCommentRequest) (*pb.EditCommentResponse, error) {
+	body, err := commentBody(req.GetBody())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorComment(ctx, req.GetTaskId(), req.GetCommentId(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	comment, err := s.comments.Update(ctx, int(req.GetCommentId()), body)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EditCommentResponse{Comment: commentToProto(comment)}, nil
+}
+
+func (s *TaskServer) DeleteComment(ctx context.Context, req *pb.DeleteCommentRequest) (*pb.DeleteCommentResponse, error) {
+	if _, err := s.authorComment(ctx, req.GetTaskId(), req.GetCommentId(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	deleted, err := s.comments.Delete(ctx, int(req.GetCommentId()))
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "comment %d not found", req.GetCommentId())
+	}
+	return &pb.DeleteCommentResponse{Success: true}, nil
+}
+
+// authorComment возвращает комментарий задачи, если userID - его автор
+func (s *TaskServer) authorComment(ctx context.Context, taskID, commentID int32, userID string) (*models.Comment, error) {
+	comment, err := s.comments.GetByID(ctx, int(commentID))
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && comment.TaskID != int(taskID)) {
+		return nil, status.Errorf(codes.NotFound, "comment %d not found", commentID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserID != userID {
+		return nil, status.Error(codes.PermissionDenied, "only the author can change a comment")
+	}
+	return comment, nil
+}
+
+func commentBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", status.Error(codes.InvalidArgument, "comment body is required")
+	}
+	if utf8.RuneCountInString(body) > maxCommentLength {
+		return "", status.Errorf(codes.InvalidArgument, "comment is longer than %d characters", maxCommentLength)
+	}
+	return body, nil
+}
+
+// commentEvent - событие COMMENT_ADDED; уведомление уйдёт владельцу и участникам задачи
+func commentEvent(task *models.Task, comment *models.Comment) *events.TaskEvent {
+	event := kafka.NewTaskEvent("COMMENT_ADDED", int32(task.ID), task.Text, task.Status, task.UserID)
+	event.Priority = task.Priority.String()
+	event.Tags = task.Tags
+	event.Assignees = task.Assignees
+	event.Watchers = task.Watchers
+	event.Comment = &events.Comment{
+		Id:     int32(comment.ID),
+		UserId: comment.UserID,
+		Body:   comment.Body,
+	}
+	return event
+}
+
+func commentToProto(comment *models.Comment) *pb.Comment {
+	protoComment := &pb.Comment{
+		Id:        int32(comment.ID),
+		TaskId:    int32(comment.TaskID),
+		UserId:    comment.UserID,
+		Body:      comment.Body,
+		CreatedAt: timestamppb.New(comment.CreatedAt),
+	}
+	if comment.UpdatedAt != nil {
+		protoComment.UpdatedAt = timestamppb.New(*comment.UpdatedAt)
+	}
+	return protoComment
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "task-service/internal/grpc/task/pb"
+	"task-service/internal/models"
+)
+
+// AddTaskMember добавляет исполнителя или наблюдателя. Новому исполнителю
+// уходит TASK_ASSIGNED (в одной транзакции с добавлением)
+func (s *TaskServer) AddTaskMember(ctx context.Context, req *pb.AddTaskMemberRequest) (*pb.AddTaskMemberResponse, error) {
+	role, userID, err := memberParams(req.GetRole(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	id := int(req.GetTaskId())
+	task, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "task %d not found", id)
+	}
+	if userID == task.UserID {
+		return nil, status.Error(codes.InvalidArgument, "task owner can't be a member")
+	}
+
+	var updated *models.Task
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		added, err := s.repo.AddMember(ctx, id, userID, role)
+		if err != nil {
+			return err
+		}
+		updated, err = s.repo.GetByID(ctx, id)
+		if err != nil || !added || role != models.MemberAssignee {
+			return err
+		}
+		return s.outbox.EnqueueTask(ctx, "TASK_ASSIGNED", updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddTaskMemberResponse{Task: taskToProto(updated)}, nil
+}
+
+func (s *TaskServer) RemoveTaskMember(ctx context.Context, req *pb.RemoveTaskMemberRequest) (*pb.RemoveTaskMemberResponse, error) {
+	role, userID, err := memberParams(req.GetRole(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	id := int(req.GetTaskId())
+
+	removed, err := s.repo.RemoveMember(ctx, id, userID, role)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, status.Errorf(codes.NotFound, "user %s is not a %s of task %d", userID, role, id)
+	}
+	task, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemoveTaskMemberResponse{Task: taskToProto(task)}, nil
+}
+
+func memberParams(role pb.MemberRole, userID string) (string, string, error) {
+	userID = strings.TrimSpace(userID)
+	if userID == "" {
+		return "", "", status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	switch role {
+	case pb.MemberRole_MEMBER_ROLE_ASSIGNEE:
+		return models.MemberAssignee, userID, nil
+	case pb.MemberRole_MEMBER_ROLE_WATCHER:
+		return models.MemberWatcher, userID, nil
+	}
+	return "", "", status.Errorf(codes.InvalidArgument, "unknown member role %d", role)
+}
+
+// memberIDs - участники из запроса без пустых, повторов и владельца
+func memberIDs(ids []string, ownerID string) []string {
+	seen := map[string]bool{ownerID: true}
+	var out []string
+	for _, id := range ids {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
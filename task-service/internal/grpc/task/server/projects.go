@@ -0,0 +1,190 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "task-service/internal/grpc/task/pb"
+	"task-service/internal/models"
+	"task-service/internal/repositories"
+)
+
+func (s *TaskServer) CreateProject(ctx context.Context, req *pb.CreateProjectRequest) (*pb.CreateProjectResponse, error) {
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "project name is required")
+	}
+	if req.GetOwnerId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner_id is required")
+	}
+	project := &models.Project{
+		Name:    name,
+		OwnerID: req.GetOwnerId(),
+	}
+	if err := applySettings(&project.Settings, req.GetSettings()); err != nil {
+		return nil, err
+	}
+
+	if err := s.projects.Create(ctx, project); err != nil {
+		return nil, err
+	}
+	return &pb.CreateProjectResponse{Project: projectToProto(project)}, nil
+}
+
+func (s *TaskServer) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.GetProjectResponse, error) {
+	project, err := s.getProject(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetProjectResponse{Project: projectToProto(project)}, nil
+}
+
+func (s *TaskServer) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	projects, err := s.projects.ListForUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	var protoProjects []*pb.Project
+	for i := range projects {
+		protoProjects = append(protoProjects, projectToProto(&projects[i]))
+	}
+	return &pb.ListProjectsResponse{Projects: protoProjects}, nil
+}
+
+func (s *TaskServer) UpdateProject(ctx context.Context, req *pb.UpdateProjectRequest) (*pb.UpdateProjectResponse, error) {
+	project, err := s.getProject(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	if name := strings.TrimSpace(req.GetName()); name != "" {
+		project.Name = name
+	}
+	if req.Settings != nil {
+		if err := applySettings(&project.Settings, req.GetSettings()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.projects.Update(ctx, project); err != nil {
+		return nil, err
+	}
+	if project, err = s.getProject(ctx, project.ID); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateProjectResponse{Project: projectToProto(project)}, nil
+}
+
+func (s *TaskServer) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
+	deleted, err := s.projects.Delete(ctx, int(req.GetId()))
+	if errors.Is(err, repositories.ErrProjectNotEmpty) {
+		return nil, status.Errorf(codes.FailedPrecondition, "project %d still has tasks", req.GetId())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "project %d not found", req.GetId())
+	}
+	return &pb.DeleteProjectResponse{Success: true}, nil
+}
+
+func (s *TaskServer) SetProjectMember(ctx context.Context, req *pb.SetProjectMemberRequest) (*pb.SetProjectMemberResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if !models.ValidProjectRole(req.GetRole()) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown project role %q", req.GetRole())
+	}
+	project, err := s.getProject(ctx, int(req.GetProjectId()))
+	if err != nil {
+		return nil, err
+	}
+	if req.GetRole() != models.ProjectOwner && isLastOwner(project, req.GetUserId()) {
+		return nil, status.Error(codes.FailedPrecondition, "project must keep at least one owner")
+	}
+
+	if err := s.projects.SetMember(ctx, project.ID, req.GetUserId(), req.GetRole()); err != nil {
+		return nil, err
+	}
+	if project, err = s.getProject(ctx, project.ID); err != nil {
+		return nil, err
+	}
+	return &pb.SetProjectMemberResponse{Project: projectToProto(project)}, nil
+}
+
+func (s *TaskServer) RemoveProjectMember(ctx context.Context, req *pb.RemoveProjectMemberRequest) (*pb.RemoveProjectMemberResponse, error) {
+	project, err := s.getProject(ctx, int(req.GetProjectId()))
+	if err != nil {
+		return nil, err
+	}
+	if isLastOwner(project, req.GetUserId()) {
+		return nil, status.Error(codes.FailedPrecondition, "project must keep at least one owner")
+	}
+
+	removed, err := s.projects.RemoveMember(ctx, project.ID, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, status.Errorf(codes.NotFound, "user %s is not a member of project %d", req.GetUserId(), project.ID)
+	}
+	if project, err = s.getProject(ctx, project.ID); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveProjectMemberResponse{Project: projectToProto(project)}, nil
+}
+
+func (s *TaskServer) getProject(ctx context.Context, id int) (*models.Project, error) {
+	project, err := s.projects.GetByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "project %d not found", id)
+	}
+	return project, err
+}
+
+// isLastOwner - userID единственный владелец проекта
+func isLastOwner(project *models.Project, userID string) bool {
+	owners, isOwner := 0, false
+	for _, m := range project.Members {
+		if m.Role == models.ProjectOwner {
+			owners++
+			isOwner = isOwner || m.UserID == userID
+		}
+	}
+	return isOwner && owners == 1
+}
+
+func applySettings(settings *models.ProjectSettings, req *pb.ProjectSettings) error {
+	if req == nil {
+		return nil
+	}
+	if req.GetActiveTaskLimit() < 0 {
+		return status.Error(codes.InvalidArgument, "active_task_limit must not be negative")
+	}
+	settings.ActiveTaskLimit = int(req.GetActiveTaskLimit())
+	return nil
+}
+
+func projectToProto(project *models.Project) *pb.Project {
+	protoProject := &pb.Project{
+		Id:      int32(project.ID),
+		Name:    project.Name,
+		OwnerId: project.OwnerID,
+		Settings: &pb.ProjectSettings{
+			ActiveTaskLimit: int32(project.Settings.ActiveTaskLimit),
+		},
+		CreatedAt: timestamppb.New(project.CreatedAt),
+		UpdatedAt: timestamppb.New(project.UpdatedAt),
+	}
+	for _, m := range project.Members {
+		protoProject.Members = append(protoProject.Members, &pb.ProjectMember{UserId: m.UserID, Role: m.Role})
+	}
+	return protoProject
+}
@@ -3,77 +3,137 @@ package server
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"log"
 	"net"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "task-service/internal/grpc/task/pb"
 	"task-service/internal/models"
 	"task-service/internal/repositories"
-	"task-service/internal/kafka"
+	"task-service/internal/outbox"
+	"task-service/internal/watch"
+	"task-service/internal/workflow"
+	events "task-service/proto/events"
 )
 
 type TaskServer struct {
 	pb.UnimplementedTaskServiceServer
 	repo repositories.TaskRepository
-	producer *kafka.TaskEventProducer
+	outbox *outbox.Store
+	engine *workflow.Engine
+	hub *watch.Hub
+	comments repositories.CommentRepository
+	attachments Attachments
+	projects repositories.ProjectRepository
+	templates repositories.TemplateRepository
+	views repositories.ViewRepository
 }
 
-func NewTaskServer(repo repositories.TaskRepository, producer *kafka.TaskEventProducer) *TaskServer {
+func NewTaskServer(repo repositories.TaskRepository, events *outbox.Store, engine *workflow.Engine, hub *watch.Hub, comments repositories.CommentRepository, attachments Attachments, projects repositories.ProjectRepository, templates repositories.TemplateRepository, views repositories.ViewRepository) *TaskServer {
 	return &TaskServer{
 		repo: repo,
-		producer: producer,
+		outbox: events,
+		engine: engine,
+		hub: hub,
+		comments: comments,
+		attachments: attachments,
+		projects: projects,
+		templates: templates,
+		views: views,
 	}
 }
 
 func (s *TaskServer) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
+	createdTask, err := s.createTask(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
-	status := req.GetStatus()
-    if status == "" {
-        status = models.TaskStatusNew  // "NEW" по умолчанию
-    }
+	return &pb.CreateTaskResponse{
+		Task: taskToProto(createdTask),
+	}, nil
+}
+
+// createTask проверяет запрос и создаёт задачу вместе с событием CREATED
+func (s *TaskServer) createTask(ctx context.Context, req *pb.CreateTaskRequest) (*models.Task, error) {
+	// задача всегда создаётся в начальном состоянии workflow
+	initial := s.engine.Definition().Initial
+	if req.GetStatus() != "" && req.GetStatus() != initial {
+		return nil, status.Errorf(codes.InvalidArgument, "task must be created in %s status", initial)
+	}
 
 	task := &models.Task{
 		Text:   req.GetText(),
 		UserID: req.GetUserId(),
-		Status: status,
+		Status: initial,
         Attempts: 0,
+		Priority: models.Priority(req.GetPriority()),
+		Tags:   req.GetTags(),
+		Assignees: memberIDs(req.GetAssignees(), req.GetUserId()),
+		Watchers:  memberIDs(req.GetWatchers(), req.GetUserId()),
 	}
-
-	id, err := s.repo.Create(ctx, task)
-	if err != nil {
-		return nil, err
+	if req.GetPriority() != pb.Priority_PRIORITY_UNSPECIFIED && !task.Priority.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown priority %d", req.GetPriority())
+	}
+	if req.DueAt != nil {
+		dueAt := req.GetDueAt().AsTime()
+		task.DueAt = &dueAt
+	}
+	if parentID := int(req.GetParentId()); parentID > 0 {
+		parent, err := s.repo.GetByID(ctx, parentID)
+		if err != nil || parent.UserID != task.UserID {
+			return nil, status.Errorf(codes.InvalidArgument, "parent task %d not found", parentID)
+		}
+		task.ParentID = &parentID
+		// подзадача по умолчанию в проекте родителя
+		if req.GetProjectId() == 0 {
+			task.ProjectID = parent.ProjectID
+		} else if parent.ProjectID == nil || *parent.ProjectID != int(req.GetProjectId()) {
+			return nil, status.Error(codes.InvalidArgument, "subtask must be in the parent's project")
+		}
+	}
+	if projectID := int(req.GetProjectId()); projectID > 0 {
+		if _, err := s.projects.GetSettings(ctx, projectID); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "project %d not found", projectID)
+		}
+		task.ProjectID = &projectID
 	}
-	
-	createdTask, err := s.repo.GetByID(ctx, id)
+
+	ctx = repositories.WithActor(ctx, models.Actor{Type: models.ActorUser, ID: req.GetUserId()}, "")
+
+	// задача и событие CREATED сохраняются одной транзакцией, в Kafka его отправит outbox relay
+	var createdTask *models.Task
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		id, err := s.repo.Create(ctx, task)
+		if err != nil {
+			return err
+		}
+		createdTask, err = s.repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		return s.outbox.EnqueueTask(ctx, "CREATED", createdTask)
+	})
 	if err != nil {
 		return nil, err
 	}
-
-    // Используем отдельный контекст для Kafka при публикации события kafka
-    if s.producer != nil {
-        go func() {
-            // Создаем новый контекст, который не зависит от родительского
-            kafkaCtx := context.Background()
-            err := s.producer.PublishTaskEvent(kafkaCtx, "CREATED",
-                int32(createdTask.ID), createdTask.Text, createdTask.Status, createdTask.UserID)
-            if err != nil {
-                log.Printf("[Kafka] Failed to publish event: %v", err)
-            }
-        }()
-    }
-
-	return &pb.CreateTaskResponse{
-		Task: taskToProto(createdTask),
-	}, nil
+	return createdTask, nil
 }
 
 func (s *TaskServer) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.GetTaskResponse, error) {
 	id := int(req.GetId())
 	task, err := s.repo.GetByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) && req.GetIncludeDeleted() {
+		task, err = s.repo.GetTrashed(ctx, id)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -83,97 +143,245 @@ func (s *TaskServer) GetTask(ctx context.Context, req *pb.GetTaskRequest) (*pb.G
 	}, nil
 }
 
-func (s *TaskServer) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
-	filter := repositories.TaskFilter{
-		UserID: req.GetUserId(),
-		Status: req.GetStatus(),
-		Page:   int(req.GetPage()),
-		Limit:  int(req.GetPageSize()),
+// asReader - кто читает: после своих изменений пользователь какое-то время читает с primary,
+// а не с реплики (read-your-writes). Берётся первый непустой id
+func asReader(ctx context.Context, userIDs ...string) context.Context {
+	for _, id := range userIDs {
+		if id != "" {
+			return repositories.WithActor(ctx, models.Actor{Type: models.ActorUser, ID: id}, "")
+		}
 	}
+	return ctx
+}
 
-	tasks, total, err := s.repo.List(ctx, filter)
+func (s *TaskServer) ListTasks(ctx context.Context, req *pb.ListTasksRequest) (*pb.ListTasksResponse, error) {
+	filter := listFilter(req)
+	ctx = asReader(ctx, req.GetUserId(), req.GetAssigneeId(), req.GetWatcherId())
+	page, err := s.repo.List(ctx, filter)
+	if errors.Is(err, repositories.ErrInvalidFilter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	var protoTasks []*pb.Task
-	for _, task := range tasks {
+	for _, task := range page.Tasks {
 		protoTasks = append(protoTasks, taskToProto(&task))
 	}
 
 	return &pb.ListTasksResponse{
 		Tasks:    protoTasks,
-		Total:    int32(total),
+		Total:    int32(page.Total),
 		Page:     req.GetPage(),
 		PageSize: req.GetPageSize(),
+		NextPageToken: page.NextPageToken,
 	}, nil
 }
 
-func (s *TaskServer) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
-	id := int(req.GetId())
-	existingTask, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		return nil, err
+// listFilter - фильтр репозитория из ListTasksRequest
+func listFilter(req *pb.ListTasksRequest) repositories.TaskFilter {
+	filter := repositories.TaskFilter{
+		UserID: req.GetUserId(),
+		Status: req.GetStatus(),
+		Page:   int(req.GetPage()),
+		Limit:  int(req.GetPageSize()),
+		Overdue: req.GetOverdue(),
+		Tags:    req.GetTags(),
+		SortBy:  req.GetSortBy(),
+		SortAsc: req.GetSortAsc(),
+		AssigneeID: req.GetAssigneeId(),
+		WatcherID:  req.GetWatcherId(),
+		ProjectID:  int(req.GetProjectId()),
+		Trashed:    req.GetTrashed(),
+		PageToken:  req.GetPageToken(),
+		SkipTotal:  req.GetSkipTotal(),
+		Statuses:   req.GetStatuses(),
+		TextPrefix: req.GetTextPrefix(),
+	}
+	for _, p := range req.GetPriorities() {
+		filter.Priorities = append(filter.Priorities, models.Priority(p))
+	}
+	filter.DueAfter = optionalTime(req.GetDueAfter())
+	filter.DueBefore = optionalTime(req.GetDueBefore())
+	filter.CreatedAfter = optionalTime(req.GetCreatedAfter())
+	filter.CreatedBefore = optionalTime(req.GetCreatedBefore())
+	filter.UpdatedAfter = optionalTime(req.GetUpdatedAfter())
+	filter.UpdatedBefore = optionalTime(req.GetUpdatedBefore())
+	filter.EndedAfter = optionalTime(req.GetEndedAfter())
+	filter.EndedBefore = optionalTime(req.GetEndedBefore())
+	if req.MinAttempts != nil {
+		minAttempts := int(req.GetMinAttempts())
+		filter.MinAttempts = &minAttempts
 	}
+	if req.MaxAttempts != nil {
+		maxAttempts := int(req.GetMaxAttempts())
+		filter.MaxAttempts = &maxAttempts
+	}
+	return filter
+}
 
-	oldStatus := existingTask.Status
-	
-	if req.GetText() != "" {
-		existingTask.Text = req.GetText()
+// optionalTime - время из необязательного поля запроса
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
 	}
-	if req.GetStatus() != "" {
-		existingTask.Status = req.GetStatus()
+	t := ts.AsTime()
+	return &t
+}
+
+func (s *TaskServer) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
+	if err := validateUpdate(req); err != nil {
+		return nil, err
 	}
-	
-	err = s.repo.Update(ctx, existingTask)
+
+	existingTask, err := s.repo.GetByID(ctx, int(req.GetId()))
 	if err != nil {
 		return nil, err
 	}
-	
-	updatedTask, err := s.repo.GetByID(ctx, id)
+
+	updatedTask, err := s.updateTask(ctx, existingTask, req)
 	if err != nil {
 		return nil, err
 	}
 
-	// Публикуем COMPLETED событие, если статус изменился на "completed"
-	if s.producer != nil && oldStatus != "completed" && updatedTask.Status == "completed" {
-		go func() {
-			kafkaCtx := context.Background()
-			err := s.producer.PublishTaskEvent(kafkaCtx, "COMPLETED",
-				int32(updatedTask.ID), updatedTask.Text, updatedTask.Status, updatedTask.UserID)
+	return &pb.UpdateTaskResponse{
+		Task: taskToProto(updatedTask),
+	}, nil
+}
+
+// updateTask применяет изменения из req к задаче. existingTask - только для проверки доступа
+// (может прийти из кэша): версия и переход проверяются по строке, заблокированной в транзакции
+func (s *TaskServer) updateTask(ctx context.Context, existingTask *models.Task, req *pb.UpdateTaskRequest) (*models.Task, error) {
+	id := existingTask.ID
+	ctx = repositories.WithActor(ctx, models.Actor{Type: models.ActorUser, ID: req.GetUserId()}, "")
+
+	// блокировка строки, изменение, on_enter действия и события в outbox - одной транзакцией
+	var updatedTask *models.Task
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		task, err := s.repo.GetForUpdate(ctx, id)
+		if err != nil {
+			return err
+		}
+		if task.Version != int(req.GetExpectedVersion()) {
+			return repositories.ErrVersionConflict
+		}
+		oldStatus := task.Status
+
+		if req.GetText() != "" {
+			task.Text = req.GetText()
+		}
+		if req.GetPriority() != pb.Priority_PRIORITY_UNSPECIFIED {
+			task.Priority = models.Priority(req.GetPriority())
+			if !task.Priority.Valid() {
+				return status.Errorf(codes.InvalidArgument, "unknown priority %d", req.GetPriority())
+			}
+		}
+		if req.GetClearDueAt() {
+			task.DueAt = nil
+		} else if req.DueAt != nil {
+			dueAt := req.GetDueAt().AsTime()
+			task.DueAt = &dueAt
+		}
+		if req.Tags != nil {
+			task.Tags = req.GetTags().GetNames()
+		}
+		if req.GetStatus() != "" && req.GetStatus() != oldStatus {
+			if err := s.engine.CanTransition(oldStatus, req.GetStatus()); err != nil {
+				return transitionError(err)
+			}
+			reason, err := s.engine.Guard(ctx, task, req.GetStatus())
 			if err != nil {
-				log.Printf("[Kafka] Failed to publish COMPLETED event: %v", err)
+				return err
+			}
+			if reason != nil {
+				return status.Errorf(codes.FailedPrecondition, "%s: %s", reason.Code, reason.Message)
+			}
+			task.Status = req.GetStatus()
+		}
+
+		if err := s.repo.Update(ctx, task); err != nil {
+			return err
+		}
+		// завершение задачи - вход в терминальное состояние: событие (TASK_CLOSED) шлёт его on_enter
+		if task.Status != oldStatus {
+			if err := s.engine.Enter(ctx, task); err != nil {
+				return err
 			}
-		}()
+		}
+		if updatedTask, err = s.repo.GetByID(ctx, id); err != nil {
+			return err
+		}
+		// UPDATED - на любое успешное изменение, его ждут подписчики WatchTasks
+		return s.outbox.EnqueueTask(ctx, "UPDATED", updatedTask)
+	})
+	if errors.Is(err, repositories.ErrVersionConflict) {
+		return nil, status.Errorf(codes.Aborted, "task %d was modified concurrently, expected version %d", id, req.GetExpectedVersion())
 	}
+	if err != nil {
+		return nil, err
+	}
+	return updatedTask, nil
+}
 
-	return &pb.UpdateTaskResponse{
-		Task: taskToProto(updatedTask),
-	}, nil
+func validateUpdate(req *pb.UpdateTaskRequest) error {
+	if req.GetExpectedVersion() <= 0 {
+		return status.Error(codes.InvalidArgument, "expected_version is required")
+	}
+	return nil
 }
 
 func (s *TaskServer) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
 	id := int(req.GetId())
-	err := s.repo.Delete(ctx, id)
+	task, err := s.repo.GetByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "task %d not found", id)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	if err := s.deleteTask(ctx, task); err != nil {
+		return nil, err
+	}
+
 	return &pb.DeleteTaskResponse{
 		Success: true,
 	}, nil
 }
 
+// deleteTask переносит задачу с подзадачами в корзину, событие DELETED - в той же транзакции
+func (s *TaskServer) deleteTask(ctx context.Context, task *models.Task) error {
+	return s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Delete(ctx, task.ID); err != nil {
+			return err
+		}
+		return s.outbox.EnqueueTask(ctx, "DELETED", task)
+	})
+}
+
 func (s *TaskServer) SearchTasks(ctx context.Context, req *pb.SearchTasksRequest) (*pb.SearchTasksResponse, error) {
     // log.Printf("[gRPC Server] SearchTasks called with query=%s, userID=%s, page=%d, pageSize=%d", 
     //     req.GetQuery(), req.GetUserId(), req.GetPage(), req.GetPageSize())
     
-    tasks, total, err := s.repo.Search(ctx, 
-        req.GetQuery(), 
-        req.GetUserId(),
-        int(req.GetPage()), 
-        int(req.GetPageSize()))
-    
+    ctx = asReader(ctx, req.GetUserId())
+    page, err := s.repo.Search(ctx, repositories.SearchFilter{
+        Query:     req.GetQuery(),
+        Mode:      req.GetMode(),
+        Language:  req.GetLanguage(),
+        UserID:    req.GetUserId(),
+        ProjectID: int(req.GetProjectId()),
+        Page:      int(req.GetPage()),
+        Limit:     int(req.GetPageSize()),
+        PageToken: req.GetPageToken(),
+        SkipTotal: req.GetSkipTotal(),
+        Facets:        req.GetFacets(),
+        CreatedBucket: req.GetCreatedBucket(),
+        Filter:        searchListFilter(req.GetFilter()),
+    })
+    if errors.Is(err, repositories.ErrInvalidFilter) {
+        return nil, status.Error(codes.InvalidArgument, err.Error())
+    }
     if err != nil {
         // log.Printf("[gRPC Server] Search error: %v", err)
         return nil, err
@@ -182,18 +390,241 @@ func (s *TaskServer) SearchTasks(ctx context.Context, req *pb.SearchTasksRequest
     // log.Printf("[gRPC Server] Search found %d tasks, total=%d", len(tasks), total)
     
     var protoTasks []*pb.Task
-    for _, task := range tasks {
+    var hits []*pb.SearchHit
+    for i, task := range page.Tasks {
         protoTasks = append(protoTasks, taskToProto(&task))
+        hits = append(hits, &pb.SearchHit{
+            TaskId:  int32(task.ID),
+            Rank:    float32(page.Hits[i].Rank),
+            Snippet: page.Hits[i].Snippet,
+        })
+    }
+    
+    var facets []*pb.Facet
+    for _, facet := range page.Facets {
+        protoFacet := &pb.Facet{Name: facet.Name}
+        for _, bucket := range facet.Buckets {
+            protoFacet.Buckets = append(protoFacet.Buckets, &pb.FacetBucket{Value: bucket.Value, Count: int32(bucket.Count)})
+        }
+        facets = append(facets, protoFacet)
     }
     
     return &pb.SearchTasksResponse{
+        Hits:     hits,
+        Facets:   facets,
         Tasks:    protoTasks,
-        Total:    int32(total),
+        Total:    int32(page.Total),
         Page:     req.GetPage(),
         PageSize: req.GetPageSize(),
+        NextPageToken: page.NextPageToken,
     }, nil
 }
 
+// searchListFilter - условия List для поиска; nil - без них
+func searchListFilter(req *pb.ListTasksRequest) *repositories.TaskFilter {
+	if req == nil {
+		return nil
+	}
+	filter := listFilter(req)
+	return &filter
+}
+
+// Подсказки по началу слов: первые задачи поиска в режиме prefix, без подсчёта total
+const (
+	defaultSuggestLimit = 10
+	maxSuggestLimit     = 20
+)
+
+func (s *TaskServer) SuggestTasks(ctx context.Context, req *pb.SuggestTasksRequest) (*pb.SuggestTasksResponse, error) {
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultSuggestLimit
+	}
+	if limit > maxSuggestLimit {
+		limit = maxSuggestLimit
+	}
+
+	ctx = asReader(ctx, req.GetUserId())
+	page, err := s.repo.Search(ctx, repositories.SearchFilter{
+		Query:     req.GetPrefix(),
+		Mode:      repositories.SearchPrefix,
+		UserID:    req.GetUserId(),
+		ProjectID: int(req.GetProjectId()),
+		Limit:     limit,
+		SkipTotal: true,
+	})
+	if errors.Is(err, repositories.ErrInvalidFilter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SuggestTasksResponse{}
+	for i, task := range page.Tasks {
+		resp.Suggestions = append(resp.Suggestions, &pb.TaskSuggestion{
+			TaskId:  int32(task.ID),
+			Text:    task.Text,
+			Snippet: page.Hits[i].Snippet,
+		})
+	}
+	return resp, nil
+}
+
+// GetTaskHistory - история смены статусов задачи
+func (s *TaskServer) GetTaskHistory(ctx context.Context, req *pb.GetTaskHistoryRequest) (*pb.GetTaskHistoryResponse, error) {
+	history, err := s.repo.GetHistory(ctx, int(req.GetTaskId()))
+	if err != nil {
+		return nil, err
+	}
+
+	var transitions []*pb.TaskTransition
+	for _, t := range history {
+		transitions = append(transitions, &pb.TaskTransition{
+			Id:         int32(t.ID),
+			TaskId:     int32(t.TaskID),
+			FromStatus: t.FromStatus,
+			ToStatus:   t.ToStatus,
+			Actor:      t.Actor.Type,
+			ActorId:    t.Actor.ID,
+			Reason:     t.Reason,
+			CreatedAt:  timestamppb.New(t.CreatedAt),
+		})
+	}
+
+	return &pb.GetTaskHistoryResponse{
+		Transitions: transitions,
+	}, nil
+}
+
+// AddDependency: task_id заблокирована blocked_by_id
+func (s *TaskServer) AddDependency(ctx context.Context, req *pb.AddDependencyRequest) (*pb.AddDependencyResponse, error) {
+	for _, id := range []int32{req.GetTaskId(), req.GetBlockedById()} {
+		if _, err := s.repo.GetByID(ctx, int(id)); err != nil {
+			return nil, status.Errorf(codes.NotFound, "task %d not found", id)
+		}
+	}
+
+	err := s.repo.AddDependency(ctx, int(req.GetTaskId()), int(req.GetBlockedById()))
+	if errors.Is(err, repositories.ErrDependencyCycle) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddDependencyResponse{Success: true}, nil
+}
+
+func (s *TaskServer) RemoveDependency(ctx context.Context, req *pb.RemoveDependencyRequest) (*pb.RemoveDependencyResponse, error) {
+	removed, err := s.repo.RemoveDependency(ctx, int(req.GetTaskId()), int(req.GetBlockedById()))
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, status.Errorf(codes.NotFound, "task %d is not blocked by task %d", req.GetTaskId(), req.GetBlockedById())
+	}
+	return &pb.RemoveDependencyResponse{Success: true}, nil
+}
+
+// GetTaskTree - задача со всеми подзадачами и блокирующими задачами
+func (s *TaskServer) GetTaskTree(ctx context.Context, req *pb.GetTaskTreeRequest) (*pb.GetTaskTreeResponse, error) {
+	rootID := int(req.GetTaskId())
+	tasks, err := s.repo.GetSubtree(ctx, rootID)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, status.Errorf(codes.NotFound, "task %d not found", rootID)
+	}
+
+	ids := make([]int, len(tasks))
+	for i, t := range tasks {
+		ids[i] = t.ID
+	}
+	blockers, err := s.repo.GetBlockers(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make(map[int]*pb.TaskNode, len(tasks))
+	for i := range tasks {
+		node := &pb.TaskNode{Task: taskToProto(&tasks[i])}
+		for j := range blockers[tasks[i].ID] {
+			node.BlockedBy = append(node.BlockedBy, taskToProto(&blockers[tasks[i].ID][j]))
+		}
+		nodes[tasks[i].ID] = node
+	}
+	// задачи отсортированы по id, поэтому подзадачи идут в порядке создания
+	for _, t := range tasks {
+		if t.ID == rootID || t.ParentID == nil {
+			continue
+		}
+		if parent, ok := nodes[*t.ParentID]; ok {
+			parent.Subtasks = append(parent.Subtasks, nodes[t.ID])
+		}
+	}
+
+	return &pb.GetTaskTreeResponse{Root: nodes[rootID]}, nil
+}
+
+// WatchTasks стримит изменения задач, пока клиент не отключится
+func (s *TaskServer) WatchTasks(req *pb.WatchTasksRequest, stream pb.TaskService_WatchTasksServer) error {
+	sub := s.hub.Subscribe(watch.Filter{UserID: req.GetUserId(), Status: req.GetStatus()})
+	defer s.hub.Unsubscribe(sub)
+
+	for {
+		select {
+		case event, ok := <-sub.Events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher is too slow, resubscribe")
+			}
+			if err := stream.Send(eventToChange(event)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func eventToChange(event *events.TaskEvent) *pb.TaskChange {
+	task := &pb.Task{
+		Id:     event.TaskId,
+		Text:   event.TaskText,
+		Status: event.TaskStatus,
+		UserId: event.UserId,
+		DueAt:  event.DueAt,
+		Tags:   event.Tags,
+		Assignees: event.Assignees,
+		Watchers:  event.Watchers,
+	}
+	if p, ok := models.ParsePriority(event.Priority); ok {
+		task.Priority = pb.Priority(p)
+	}
+	if r := event.FailureReason; r != nil {
+		task.FailureReason = &pb.FailureReason{
+			Code:      r.Code,
+			Validator: r.Validator,
+			Message:   r.Message,
+			Details:   r.Details,
+		}
+	}
+	return &pb.TaskChange{
+		EventId:    event.EventId,
+		EventType:  event.EventType,
+		Task:       task,
+		OccurredAt: event.Timestamp,
+	}
+}
+
+// transitionError переводит ошибку workflow в gRPC статус
+func transitionError(err error) error {
+	if errors.Is(err, workflow.ErrUnknownState) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.FailedPrecondition, err.Error())
+}
+
 // Вспомогательная функция для преобразования модели в proto
 func taskToProto(task *models.Task) *pb.Task {
 	protoTask := &pb.Task{ 
@@ -202,6 +633,31 @@ func taskToProto(task *models.Task) *pb.Task {
 		Status:    task.Status,
 		UserId:    task.UserID,
 		CreatedAt: timestamppb.New(task.CreatedAt),
+		Version:   int32(task.Version),
+		Priority:  pb.Priority(task.Priority),
+		Tags:      task.Tags,
+		Assignees: task.Assignees,
+		Watchers:  task.Watchers,
+	}
+
+	if task.ParentID != nil {
+		protoTask.ParentId = int32(*task.ParentID)
+	}
+
+	if task.ProjectID != nil {
+		protoTask.ProjectId = int32(*task.ProjectID)
+	}
+
+	if task.DeletedAt != nil {
+		protoTask.DeletedAt = timestamppb.New(*task.DeletedAt)
+	}
+
+	if task.DueAt != nil {
+		protoTask.DueAt = timestamppb.New(*task.DueAt)
+	}
+
+	if task.OverdueAt != nil {
+		protoTask.OverdueAt = timestamppb.New(*task.OverdueAt)
 	}
 
 	if task.StartedAt != nil {
@@ -212,18 +668,27 @@ func taskToProto(task *models.Task) *pb.Task {
 		protoTask.EndedAt = timestamppb.New(*task.EndedAt)
 	}
 
+	if r := task.FailureReason; r != nil {
+		protoTask.FailureReason = &pb.FailureReason{
+			Code:      r.Code,
+			Validator: r.Validator,
+			Message:   r.Message,
+			Details:   r.Details,
+		}
+	}
+
 	return protoTask
 }
 
 // Запуск gRPC сервера
-func StartServer(repo repositories.TaskRepository, producer *kafka.TaskEventProducer, port string) error {
+func StartServer(repo repositories.TaskRepository, events *outbox.Store, engine *workflow.Engine, hub *watch.Hub, comments repositories.CommentRepository, attachments Attachments, projects repositories.ProjectRepository, templates repositories.TemplateRepository, views repositories.ViewRepository, port string) error {
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
 	}
 
 	s := grpc.NewServer()
-	pb.RegisterTaskServiceServer(s, NewTaskServer(repo, producer))
+	pb.RegisterTaskServiceServer(s, NewTaskServer(repo, events, engine, hub, comments, attachments, projects, templates, views))
 	reflection.Register(s)
 
 	log.Printf("gRPC Task Service запущен на порту %s", port)
@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "task-service/internal/grpc/task/pb"
+	"task-service/internal/models"
+	"task-service/internal/recurrence"
+)
+
+func (s *TaskServer) CreateTaskTemplate(ctx context.Context, req *pb.CreateTaskTemplateRequest) (*pb.CreateTaskTemplateResponse, error) {
+	in := req.GetTemplate()
+	if in.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	tmpl := &models.TaskTemplate{
+		UserID:    in.GetUserId(),
+		Text:      strings.TrimSpace(in.GetText()),
+		Priority:  models.Priority(in.GetPriority()),
+		Tags:      in.GetTags(),
+		Assignees: memberIDs(in.GetAssignees(), in.GetUserId()),
+		Watchers:  memberIDs(in.GetWatchers(), in.GetUserId()),
+		Schedule:  strings.TrimSpace(in.GetSchedule()),
+		Timezone:  in.GetTimezone(),
+		DueIn:     time.Duration(in.GetDueInSeconds()) * time.Second,
+		Paused:    in.GetPaused(),
+	}
+	if tmpl.Text == "" {
+		return nil, status.Error(codes.InvalidArgument, "template text is required")
+	}
+	if in.GetPriority() != pb.Priority_PRIORITY_UNSPECIFIED && !tmpl.Priority.Valid() {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown priority %d", in.GetPriority())
+	}
+	if in.GetDueInSeconds() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "due_in_seconds must not be negative")
+	}
+	if projectID := int(in.GetProjectId()); projectID > 0 {
+		if _, err := s.projects.GetSettings(ctx, projectID); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "project %d not found", projectID)
+		}
+		tmpl.ProjectID = &projectID
+	}
+	if err := scheduleNextRun(tmpl, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.templates.Create(ctx, tmpl); err != nil {
+		return nil, err
+	}
+	return &pb.CreateTaskTemplateResponse{Template: templateToProto(tmpl)}, nil
+}
+
+func (s *TaskServer) GetTaskTemplate(ctx context.Context, req *pb.GetTaskTemplateRequest) (*pb.GetTaskTemplateResponse, error) {
+	tmpl, err := s.getTemplate(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTaskTemplateResponse{Template: templateToProto(tmpl)}, nil
+}
+
+func (s *TaskServer) ListTaskTemplates(ctx context.Context, req *pb.ListTaskTemplatesRequest) (*pb.ListTaskTemplatesResponse, error) {
+	templates, err := s.templates.ListByUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	var protoTemplates []*pb.TaskTemplate
+	for i := range templates {
+		protoTemplates = append(protoTemplates, templateToProto(&templates[i]))
+	}
+	return &pb.ListTaskTemplatesResponse{Templates: protoTemplates}, nil
+}
+
+// UpdateTaskTemplate меняет шаблон; при смене расписания или возобновлении
+// следующее срабатывание считается заново от текущего момента
+func (s *TaskServer) UpdateTaskTemplate(ctx context.Context, req *pb.UpdateTaskTemplateRequest) (*pb.UpdateTaskTemplateResponse, error) {
+	tmpl, err := s.getTemplate(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	reschedule := false
+	if text := strings.TrimSpace(req.GetText()); text != "" {
+		tmpl.Text = text
+	}
+	if req.GetPriority() != pb.Priority_PRIORITY_UNSPECIFIED {
+		priority := models.Priority(req.GetPriority())
+		if !priority.Valid() {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown priority %d", req.GetPriority())
+		}
+		tmpl.Priority = priority
+	}
+	if req.Tags != nil {
+		tmpl.Tags = req.GetTags().GetNames()
+	}
+	if schedule := strings.TrimSpace(req.GetSchedule()); schedule != "" {
+		tmpl.Schedule = schedule
+		reschedule = true
+	}
+	if req.GetTimezone() != "" {
+		tmpl.Timezone = req.GetTimezone()
+		reschedule = true
+	}
+	switch {
+	case req.GetClearDueIn():
+		tmpl.DueIn = 0
+	case req.GetDueInSeconds() < 0:
+		return nil, status.Error(codes.InvalidArgument, "due_in_seconds must not be negative")
+	case req.GetDueInSeconds() > 0:
+		tmpl.DueIn = time.Duration(req.GetDueInSeconds()) * time.Second
+	}
+	switch req.GetState() {
+	case pb.TemplateState_TEMPLATE_STATE_PAUSED:
+		tmpl.Paused = true
+	case pb.TemplateState_TEMPLATE_STATE_ACTIVE:
+		// пропущенные за паузу срабатывания не догоняем
+		reschedule = reschedule || tmpl.Paused
+		tmpl.Paused = false
+	}
+	if reschedule {
+		if err := scheduleNextRun(tmpl, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.templates.Update(ctx, tmpl); err != nil {
+		return nil, err
+	}
+	return &pb.UpdateTaskTemplateResponse{Template: templateToProto(tmpl)}, nil
+}
+
+func (s *TaskServer) DeleteTaskTemplate(ctx context.Context, req *pb.DeleteTaskTemplateRequest) (*pb.DeleteTaskTemplateResponse, error) {
+	deleted, err := s.templates.Delete(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "template %d not found", req.GetId())
+	}
+	return &pb.DeleteTaskTemplateResponse{Success: true}, nil
+}
+
+func (s *TaskServer) getTemplate(ctx context.Context, id int) (*models.TaskTemplate, error) {
+	tmpl, err := s.templates.GetByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "template %d not found", id)
+	}
+	return tmpl, err
+}
+
+// scheduleNextRun проверяет расписание шаблона и считает первое срабатывание после now
+func scheduleNextRun(tmpl *models.TaskTemplate, now time.Time) error {
+	if tmpl.Timezone == "" {
+		tmpl.Timezone = "UTC"
+	}
+	schedule, err := recurrence.ParseIn(tmpl.Schedule, tmpl.Timezone)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	next := schedule.Next(now)
+	if next.IsZero() {
+		return status.Errorf(codes.InvalidArgument, "schedule %q never fires", tmpl.Schedule)
+	}
+	tmpl.NextRunAt = &next
+	return nil
+}
+
+func templateToProto(tmpl *models.TaskTemplate) *pb.TaskTemplate {
+	protoTemplate := &pb.TaskTemplate{
+		Id:           int32(tmpl.ID),
+		UserId:       tmpl.UserID,
+		Text:         tmpl.Text,
+		Priority:     pb.Priority(tmpl.Priority),
+		Tags:         tmpl.Tags,
+		Assignees:    tmpl.Assignees,
+		Watchers:     tmpl.Watchers,
+		Schedule:     tmpl.Schedule,
+		Timezone:     tmpl.Timezone,
+		DueInSeconds: int32(tmpl.DueIn / time.Second),
+		Paused:       tmpl.Paused,
+		CreatedAt:    timestamppb.New(tmpl.CreatedAt),
+	}
+	if tmpl.ProjectID != nil {
+		protoTemplate.ProjectId = int32(*tmpl.ProjectID)
+	}
+	if tmpl.NextRunAt != nil {
+		protoTemplate.NextRunAt = timestamppb.New(*tmpl.NextRunAt)
+	}
+	if tmpl.LastRunAt != nil {
+		protoTemplate.LastRunAt = timestamppb.New(*tmpl.LastRunAt)
+	}
+	return protoTemplate
+}
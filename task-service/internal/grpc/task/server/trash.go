@@ -0,0 +1,50 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "task-service/internal/grpc/task/pb"
+	"task-service/internal/models"
+	"task-service/internal/repositories"
+)
+
+// RestoreTask возвращает задачу из корзины; подзадачу - только если её родитель не в корзине
+func (s *TaskServer) RestoreTask(ctx context.Context, req *pb.RestoreTaskRequest) (*pb.RestoreTaskResponse, error) {
+	id := int(req.GetId())
+	if _, err := s.repo.GetTrashed(ctx, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "task %d is not in trash", id)
+		}
+		return nil, err
+	}
+
+	ctx = repositories.WithActor(ctx, models.Actor{Type: models.ActorUser, ID: req.GetUserId()}, "restore")
+
+	var restored *models.Task
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.Restore(ctx, id); err != nil {
+			return err
+		}
+		var err error
+		if restored, err = s.repo.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return s.outbox.EnqueueTask(ctx, "RESTORED", restored)
+	})
+	switch {
+	case errors.Is(err, repositories.ErrParentDeleted):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, sql.ErrNoRows):
+		// задачу успели восстановить или очистить
+		return nil, status.Errorf(codes.NotFound, "task %d is not in trash", id)
+	case err != nil:
+		return nil, err
+	}
+
+	return &pb.RestoreTaskResponse{Task: taskToProto(restored)}, nil
+}
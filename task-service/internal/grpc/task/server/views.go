@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "task-service/internal/grpc/task/pb"
+	"task-service/internal/models"
+	"task-service/internal/repositories"
+)
+
+const maxViewNameLength = 100
+
+func (s *TaskServer) CreateSavedView(ctx context.Context, req *pb.CreateSavedViewRequest) (*pb.CreateSavedViewResponse, error) {
+	if req.GetView().GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	view, err := viewFromProto(req.GetView())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.views.Create(ctx, view); err != nil {
+		return nil, err
+	}
+	protoView, err := viewToProto(view)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateSavedViewResponse{View: protoView}, nil
+}
+
+func (s *TaskServer) GetSavedView(ctx context.Context, req *pb.GetSavedViewRequest) (*pb.GetSavedViewResponse, error) {
+	view, err := s.getView(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	protoView, err := viewToProto(view)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSavedViewResponse{View: protoView}, nil
+}
+
+func (s *TaskServer) ListSavedViews(ctx context.Context, req *pb.ListSavedViewsRequest) (*pb.ListSavedViewsResponse, error) {
+	views, err := s.views.ListByUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListSavedViewsResponse{}
+	for i := range views {
+		protoView, err := viewToProto(&views[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Views = append(resp.Views, protoView)
+	}
+	return resp, nil
+}
+
+func (s *TaskServer) UpdateSavedView(ctx context.Context, req *pb.UpdateSavedViewRequest) (*pb.UpdateSavedViewResponse, error) {
+	existing, err := s.getView(ctx, int(req.GetView().GetId()))
+	if err != nil {
+		return nil, err
+	}
+	view, err := viewFromProto(req.GetView())
+	if err != nil {
+		return nil, err
+	}
+	view.UserID = existing.UserID
+	view.CreatedAt = existing.CreatedAt
+
+	if err := s.views.Update(ctx, view); err != nil {
+		return nil, err
+	}
+	protoView, err := viewToProto(view)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateSavedViewResponse{View: protoView}, nil
+}
+
+func (s *TaskServer) DeleteSavedView(ctx context.Context, req *pb.DeleteSavedViewRequest) (*pb.DeleteSavedViewResponse, error) {
+	deleted, err := s.views.Delete(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, status.Errorf(codes.NotFound, "view %d not found", req.GetId())
+	}
+	return &pb.DeleteSavedViewResponse{Success: true}, nil
+}
+
+func (s *TaskServer) getView(ctx context.Context, id int) (*models.SavedView, error) {
+	view, err := s.views.GetByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "view %d not found", id)
+	}
+	return view, err
+}
+
+// viewFromProto проверяет представление; пагинация из фильтра не сохраняется
+func viewFromProto(in *pb.SavedView) (*models.SavedView, error) {
+	view := &models.SavedView{
+		ID:         int(in.GetId()),
+		UserID:     in.GetUserId(),
+		Name:       strings.TrimSpace(in.GetName()),
+		Query:      strings.TrimSpace(in.GetQuery()),
+		SearchMode: in.GetSearchMode(),
+		Language:   in.GetLanguage(),
+	}
+	if view.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "view name is required")
+	}
+	if utf8.RuneCountInString(view.Name) > maxViewNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "view name is longer than %d characters", maxViewNameLength)
+	}
+	if err := repositories.ValidSearchOptions(view.SearchMode, view.Language); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter := &pb.ListTasksRequest{}
+	if in.GetFilter() != nil {
+		filter = proto.Clone(in.GetFilter()).(*pb.ListTasksRequest)
+	}
+	filter.Page, filter.PageSize, filter.PageToken, filter.SkipTotal = 0, 0, "", false
+	data, err := protojson.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+	view.Filter = data
+	return view, nil
+}
+
+func viewToProto(view *models.SavedView) (*pb.SavedView, error) {
+	filter := &pb.ListTasksRequest{}
+	// поля, которых нет в этой версии ListTasksRequest, пропускаем
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(view.Filter, filter); err != nil {
+		return nil, err
+	}
+	return &pb.SavedView{
+		Id:         int32(view.ID),
+		UserId:     view.UserID,
+		Name:       view.Name,
+		Query:      view.Query,
+		SearchMode: view.SearchMode,
+		Language:   view.Language,
+		Filter:     filter,
+		CreatedAt:  timestamppb.New(view.CreatedAt),
+		UpdatedAt:  timestamppb.New(view.UpdatedAt),
+	}, nil
+}
@@ -176,7 +176,7 @@ func (h *TaskHandler) UpdateTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedTask, err := h.taskClient.UpdateTask(r.Context(), int32(id), input.Text, input.Status)
+	updatedTask, err := h.taskClient.UpdateTask(r.Context(), int32(id), input.Text, input.Status, task.GetVersion())
 	if err != nil {
 		log.Printf("UpdateTask error: %v", err)
 		http.Error(w, "failed to update task", http.StatusInternalServerError)
@@ -260,7 +260,7 @@ func (h *TaskHandler) CloseTask(w http.ResponseWriter, r *http.Request) {
         return
     }
     
-    _, err = h.taskClient.UpdateTask(r.Context(), int32(id), task.GetText(), models.TaskStatusClosed)
+    _, err = h.taskClient.UpdateTask(r.Context(), int32(id), task.GetText(), models.TaskStatusClosed, task.GetVersion())
     if err != nil {
         http.Error(w, "Failed to close task", http.StatusInternalServerError)
         return
@@ -13,6 +13,9 @@ import (
     events "task-service/proto/events"
 )
 
+// BatchEventType - заголовок event-type сообщения с TaskBatchEvent вместо TaskEvent
+const BatchEventType = "BATCH"
+
 type TaskEventProducer struct {
     writer *kafka.Writer
 }
@@ -35,15 +38,12 @@ func NewTaskEventProducer(brokers []string, topic string) *TaskEventProducer {
 }
 
 func (p *TaskEventProducer) PublishTaskEvent(ctx context.Context, eventType string, taskID int32, text, status, userID string) error {
+    return p.PublishEvent(ctx, NewTaskEvent(eventType, taskID, text, status, userID))
+}
 
-    if p.writer == nil {
-        log.Println("[Kafka] Producer not configured, skipping event")
-        return nil
-    }
-    
-    log.Printf("[Kafka] Publishing event: type=%s, taskID=%d, userID=%s", eventType, taskID, userID)
-
-    event := &events.TaskEvent{
+// NewTaskEvent собирает событие задачи; дополнительные поля (например, FailureReason) заполняет вызывающий
+func NewTaskEvent(eventType string, taskID int32, text, status, userID string) *events.TaskEvent {
+    return &events.TaskEvent{
         EventId:    fmt.Sprintf("%d-%d", taskID, time.Now().UnixNano()),
         EventType:  eventType,
         TaskId:     taskID,
@@ -52,6 +52,16 @@ func (p *TaskEventProducer) PublishTaskEvent(ctx context.Context, eventType stri
         UserId:     userID,
         Timestamp:  timestamppb.Now(),
     }
+}
+
+func (p *TaskEventProducer) PublishEvent(ctx context.Context, event *events.TaskEvent) error {
+
+    if p.writer == nil {
+        log.Println("[Kafka] Producer not configured, skipping event")
+        return nil
+    }
+    
+    log.Printf("[Kafka] Publishing event: type=%s, taskID=%d, userID=%s", event.EventType, event.TaskId, event.UserId)
     
     data, err := proto.Marshal(event)
     if err != nil {
@@ -62,10 +72,10 @@ func (p *TaskEventProducer) PublishTaskEvent(ctx context.Context, eventType stri
     kafkaCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
     defer cancel()
     err = p.writer.WriteMessages(kafkaCtx, kafka.Message{
-        Key:   []byte(fmt.Sprintf("task-%d", taskID)),
+        Key:   []byte(fmt.Sprintf("task-%d", event.TaskId)),
         Value: data,
         Headers: []kafka.Header{
-            {Key: "event-type", Value: []byte(eventType)},
+            {Key: "event-type", Value: []byte(event.EventType)},
         },
     })
     
@@ -78,6 +88,44 @@ func (p *TaskEventProducer) PublishTaskEvent(ctx context.Context, eventType stri
     return nil
 }
 
+// PublishBatch отправляет пакет событий одним сообщением с заголовком event-type: BATCH
+func (p *TaskEventProducer) PublishBatch(ctx context.Context, batch *events.TaskBatchEvent) error {
+
+    if p.writer == nil {
+        log.Println("[Kafka] Producer not configured, skipping batch")
+        return nil
+    }
+    if len(batch.Events) == 0 {
+        return nil
+    }
+
+    log.Printf("[Kafka] Publishing batch: %d events", len(batch.Events))
+
+    data, err := proto.Marshal(batch)
+    if err != nil {
+        return fmt.Errorf("failed to marshal batch: %w", err)
+    }
+
+    kafkaCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+    defer cancel()
+    err = p.writer.WriteMessages(kafkaCtx, kafka.Message{
+        // ключ по первому событию: пакет целиком попадает в одну партицию
+        Key:   []byte(fmt.Sprintf("task-%d", batch.Events[0].TaskId)),
+        Value: data,
+        Headers: []kafka.Header{
+            {Key: "event-type", Value: []byte(BatchEventType)},
+        },
+    })
+
+    if err != nil {
+        log.Printf("[Kafka] Write error: %v", err)
+        return fmt.Errorf("failed to write message: %w", err)
+    }
+
+    log.Printf("[Kafka] Batch published successfully")
+    return nil
+}
+
 func (p *TaskEventProducer) Close() error {
     return p.writer.Close()
 }
\ No newline at end of file
@@ -0,0 +1,155 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// StateMachineLockKey - ключ advisory lock для выбора лидера state machine
+const StateMachineLockKey int64 = 0x7461736b736d // "tasksm"
+
+// Elector выбирает лидера среди реплик через session-level advisory lock Postgres.
+// Lock держится на выделенном соединении: если соединение рвётся,
+// Postgres снимает lock сам и лидером становится другая реплика
+type Elector struct {
+	db         *sql.DB
+	key        int64
+	instanceID string
+	interval   time.Duration
+
+	mu      sync.RWMutex
+	conn    *sql.Conn
+	leader  bool
+	since   time.Time
+	changes int64
+}
+
+// Status - состояние выборов для логов и /leader
+type Status struct {
+	Instance string    `json:"instance"`
+	Leader   bool      `json:"leader"`
+	Since    time.Time `json:"since,omitempty"`
+	Changes  int64     `json:"leadership_changes"`
+}
+
+func NewElector(db *sql.DB, key int64, instanceID string, interval time.Duration) *Elector {
+	return &Elector{
+		db:         db,
+		key:        key,
+		instanceID: instanceID,
+		interval:   interval,
+	}
+}
+
+// InstanceID - INSTANCE_ID из окружения или host-pid
+func InstanceID() string {
+	if id := os.Getenv("INSTANCE_ID"); id != "" {
+		return id
+	}
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d", host, os.Getpid())
+}
+
+func (e *Elector) Start(ctx context.Context) {
+	go func() {
+		log.Printf("[leader] elector started, instance=%s", e.instanceID)
+		defer log.Println("[leader] elector stopped")
+
+		ticker := time.NewTicker(e.interval)
+		defer ticker.Stop()
+
+		e.tick(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				e.tick(ctx)
+			case <-ctx.Done():
+				e.resign()
+				return
+			}
+		}
+	}()
+}
+
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader
+}
+
+func (e *Elector) Status() Status {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return Status{Instance: e.instanceID, Leader: e.leader, Since: e.since, Changes: e.changes}
+}
+
+func (e *Elector) tick(ctx context.Context) {
+	dbCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	if e.IsLeader() {
+		// lock жив, пока живо соединение
+		if err := e.conn.PingContext(dbCtx); err != nil {
+			log.Printf("[leader] instance %s lost leadership: %v", e.instanceID, err)
+			e.release()
+		}
+		return
+	}
+
+	conn, err := e.db.Conn(dbCtx)
+	if err != nil {
+		log.Printf("[leader] failed to get connection: %v", err)
+		return
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(dbCtx, `SELECT pg_try_advisory_lock($1)`, e.key).Scan(&acquired); err != nil || !acquired {
+		if err != nil {
+			log.Printf("[leader] failed to try lock: %v", err)
+		}
+		conn.Close()
+		return
+	}
+
+	e.mu.Lock()
+	e.conn = conn
+	e.leader = true
+	e.since = time.Now()
+	e.changes++
+	e.mu.Unlock()
+	log.Printf("[leader] instance %s is now the state machine leader", e.instanceID)
+}
+
+// resign отпускает lock при остановке, чтобы другая реплика не ждала разрыва соединения
+func (e *Elector) resign() {
+	if e.IsLeader() {
+		log.Printf("[leader] instance %s resigns", e.instanceID)
+		e.release()
+	}
+}
+
+func (e *Elector) release() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	_, err := e.conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, e.key)
+	cancel()
+	if err != nil {
+		// соединение с возможно не снятым lock нельзя возвращать в пул
+		e.conn.Raw(func(any) error { return driver.ErrBadConn })
+	}
+	e.conn.Close()
+	e.conn = nil
+	e.leader = false
+	e.since = time.Time{}
+}
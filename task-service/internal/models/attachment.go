@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Attachment - файл, прикреплённый к задаче; содержимое лежит в BlobStore под StorageKey
+type Attachment struct {
+	ID          int       `json:"id"`
+	TaskID      int       `json:"task_id"`
+	UserID      string    `json:"user_id"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"` // sha256, hex
+	StorageKey  string    `json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
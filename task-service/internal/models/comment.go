@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// Comment - комментарий к задаче
+type Comment struct {
+	ID        int        `json:"id"`
+	TaskID    int        `json:"task_id"`
+	UserID    string     `json:"user_id"` // автор
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"` // nil - не редактировался
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// Project - проект, в котором живут задачи
+type Project struct {
+	ID        int             `json:"id"`
+	Name      string          `json:"name"`
+	OwnerID   string          `json:"owner_id"`
+	Settings  ProjectSettings `json:"settings"`
+	CreatedAt time.Time       `json:"created_at"`
+	UpdatedAt time.Time       `json:"updated_at"`
+	Members   []ProjectMember `json:"members,omitempty"`
+}
+
+// ProjectSettings - настройки workflow проекта; нулевые значения - как в workflow
+type ProjectSettings struct {
+	ActiveTaskLimit int `json:"active_task_limit,omitempty"` // активных задач пользователя в проекте
+}
+
+type ProjectMember struct {
+	UserID string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+// Роли в проекте
+const (
+	ProjectOwner  = "owner"  // управляет проектом и участниками
+	ProjectEditor = "editor" // создаёт и меняет задачи проекта
+	ProjectViewer = "viewer" // только смотрит
+)
+
+// ValidProjectRole - роль, которую можно выдать участнику
+func ValidProjectRole(role string) bool {
+	return role == ProjectOwner || role == ProjectEditor || role == ProjectViewer
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 )
 
@@ -19,8 +20,36 @@ type Task struct {
     Validation1At *time.Time `json:"validation1_at,omitempty"`
     Validation2At *time.Time `json:"validation2_at,omitempty"`
     ClosedAt      *time.Time `json:"closed_at,omitempty"`
+
+	// причина последней неудачной валидации
+	FailureReason *ValidationReason `json:"failure_reason,omitempty"`
+
+	// увеличивается при каждом изменении задачи (optimistic locking)
+	Version int `json:"version"`
+
+	// планирование
+	Priority  Priority   `json:"priority"`
+	DueAt     *time.Time `json:"due_at,omitempty"`
+	OverdueAt *time.Time `json:"overdue_at,omitempty"` // когда state machine отметила просрочку
+	Tags      []string   `json:"tags,omitempty"`
+
+	// подзадача: id родительской задачи
+	ParentID *int `json:"parent_id,omitempty"`
+
+	ProjectID *int       `json:"project_id,omitempty"` // nil - задача вне проекта
+	DeletedAt *time.Time `json:"deleted_at,omitempty"` // задача в корзине
+
+	// кроме владельца (UserID) задачу видят исполнители и наблюдатели
+	Assignees []string `json:"assignees,omitempty"`
+	Watchers  []string `json:"watchers,omitempty"`
 }
 
+// Роли участников задачи
+const (
+	MemberAssignee = "assignee" // исполнитель: может менять задачу
+	MemberWatcher  = "watcher"  // наблюдатель: видит задачу и получает уведомления
+)
+
 type TaskStatusEvent struct {
 	Type      string    `json:"type"`
 	TaskID    int       `json:"task_id"`
@@ -42,4 +71,86 @@ const (
     TaskStatusValidation2           = "VALIDATION_2"
     TaskStatusReadyForClosure       = "READY_FOR_CLOSURE"
     TaskStatusClosed                = "CLOSED"
-)
\ No newline at end of file
+)
+// ValidationReason - структурированная причина, по которой задача не прошла валидацию
+type ValidationReason struct {
+	Code      string            `json:"code"`
+	Validator string            `json:"validator,omitempty"`
+	Message   string            `json:"message"`
+	Details   map[string]string `json:"details,omitempty"`
+}
+
+// Actor - кто изменил задачу
+type Actor struct {
+	Type string `json:"type"`
+	ID   string `json:"id,omitempty"`
+}
+
+const (
+	ActorUser         = "user"
+	ActorStateMachine = "state_machine"
+	ActorWorker       = "worker"
+	ActorSystem       = "system"
+	ActorScheduler    = "scheduler" // задача создана по шаблону, ID - id шаблона
+)
+
+// TaskTransition - запись истории смены статуса задачи
+type TaskTransition struct {
+	ID         int       `json:"id"`
+	TaskID     int       `json:"task_id"`
+	FromStatus string    `json:"from_status,omitempty"` // пусто для создания задачи
+	ToStatus   string    `json:"to_status"`
+	Actor      Actor     `json:"actor"`
+	Reason     string    `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Priority - приоритет задачи; значения совпадают с enum Priority в task.proto
+type Priority int
+
+const (
+	PriorityUnspecified Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+	PriorityCritical
+)
+
+var priorityNames = map[Priority]string{
+	PriorityLow:      "LOW",
+	PriorityMedium:   "MEDIUM",
+	PriorityHigh:     "HIGH",
+	PriorityCritical: "CRITICAL",
+}
+
+func (p Priority) String() string {
+	if name, ok := priorityNames[p]; ok {
+		return name
+	}
+	return "UNSPECIFIED"
+}
+
+func (p Priority) Valid() bool {
+	return p >= PriorityLow && p <= PriorityCritical
+}
+
+// ParsePriority разбирает имя приоритета (LOW, medium, ...)
+func ParsePriority(s string) (Priority, bool) {
+	for p, name := range priorityNames {
+		if strings.EqualFold(name, s) {
+			return p, true
+		}
+	}
+	return PriorityUnspecified, false
+}
+
+// Escalate - следующий по важности приоритет (CRITICAL остаётся CRITICAL)
+func (p Priority) Escalate() Priority {
+	if p < PriorityLow {
+		return PriorityHigh
+	}
+	if p >= PriorityCritical {
+		return PriorityCritical
+	}
+	return p + 1
+}
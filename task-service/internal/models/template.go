@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// TaskTemplate - шаблон повторяющейся задачи: по расписанию Schedule
+// (cron в поясе Timezone) scheduler создаёт из него новую задачу
+type TaskTemplate struct {
+	ID        int           `json:"id"`
+	UserID    string        `json:"user_id"`
+	Text      string        `json:"text"`
+	Priority  Priority      `json:"priority"`
+	Tags      []string      `json:"tags,omitempty"`
+	Assignees []string      `json:"assignees,omitempty"`
+	Watchers  []string      `json:"watchers,omitempty"`
+	ProjectID *int          `json:"project_id,omitempty"`
+	Schedule  string        `json:"schedule"`
+	Timezone  string        `json:"timezone"`
+	DueIn     time.Duration `json:"due_in,omitempty"` // срок задачи относительно срабатывания
+	Paused    bool          `json:"paused"`
+	NextRunAt *time.Time    `json:"next_run_at,omitempty"` // nil - больше не сработает
+	LastRunAt *time.Time    `json:"last_run_at,omitempty"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
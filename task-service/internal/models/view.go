@@ -0,0 +1,20 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SavedView - сохранённое представление пользователя: фильтр и сортировка списка задач,
+// с непустым Query - поиск с этими условиями
+type SavedView struct {
+	ID         int             `json:"id"`
+	UserID     string          `json:"user_id"`
+	Name       string          `json:"name"`
+	Query      string          `json:"query,omitempty"`
+	SearchMode string          `json:"search_mode,omitempty"`
+	Language   string          `json:"language,omitempty"`
+	Filter     json.RawMessage `json:"filter"` // ListTasksRequest в protojson
+	CreatedAt  time.Time       `json:"created_at"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+}
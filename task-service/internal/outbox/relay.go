@@ -0,0 +1,132 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"task-service/internal/kafka"
+	events "task-service/proto/events"
+)
+
+// Publisher - куда relay отправляет события (kafka.TaskEventProducer)
+type Publisher interface {
+	PublishEvent(ctx context.Context, event *events.TaskEvent) error
+	PublishBatch(ctx context.Context, batch *events.TaskBatchEvent) error
+}
+
+const (
+	defaultBatchSize = 100
+	maxRetryDelay    = 5 * time.Minute
+	// после стольких неудачных попыток (около часа ретраев) событие откладывается (dead_at)
+	defaultMaxAttempts = 25
+	// claimLease - на сколько relay забирает события; publishTimeout - предел одной публикации
+	claimLease     = time.Minute
+	publishTimeout = 10 * time.Second
+)
+
+// Relay периодически забирает неотправленные события из outbox и публикует их.
+// Доставка at-least-once: при сбое после публикации событие уйдёт повторно,
+// поэтому потребители должны быть идемпотентны по event_id
+type Relay struct {
+	store       *Store
+	publisher   Publisher
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+}
+
+func NewRelay(store *Store, publisher Publisher, interval time.Duration) *Relay {
+	return &Relay{
+		store:       store,
+		publisher:   publisher,
+		interval:    interval,
+		batchSize:   defaultBatchSize,
+		maxAttempts: defaultMaxAttempts,
+	}
+}
+
+func (r *Relay) Start(ctx context.Context) {
+	go func() {
+		log.Println("[outbox] relay started")
+		defer log.Println("[outbox] relay stopped")
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := r.relayBatch(ctx); err != nil {
+					log.Printf("[outbox] relay error: %v", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (r *Relay) relayBatch(ctx context.Context) error {
+	records, err := r.store.claim(ctx, r.batchSize, claimLease)
+	if err != nil {
+		return err
+	}
+
+	// последняя публикация должна закончиться до конца аренды, иначе событие заберут повторно
+	deadline := time.Now().Add(claimLease - publishTimeout)
+	for _, rec := range records {
+		if time.Now().After(deadline) {
+			// остальные вернутся в очередь, когда истечёт аренда
+			break
+		}
+		if err := r.deliver(ctx, rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliver публикует одно событие и отмечает результат: отправлено, повтор позже
+// или, после maxAttempts неудач, отложено (dead_at)
+func (r *Relay) deliver(ctx context.Context, rec record) error {
+	pubCtx, cancel := context.WithTimeout(ctx, publishTimeout)
+	err := r.publish(pubCtx, rec)
+	cancel()
+	if err == nil {
+		return r.store.markSent(ctx, rec.ID)
+	}
+	if rec.Attempts+1 >= r.maxAttempts {
+		log.Printf("[outbox] event %d (%s) failed %d times, parked: %v", rec.ID, rec.eventType(), rec.Attempts+1, err)
+		return r.store.markDead(ctx, rec.ID, err)
+	}
+	delay := retryDelay(rec.Attempts)
+	log.Printf("[outbox] event %d (%s) failed, retry in %s: %v", rec.ID, rec.eventType(), delay, err)
+	return r.store.markFailed(ctx, rec.ID, err, delay)
+}
+
+func (r *Relay) publish(ctx context.Context, rec record) error {
+	if rec.Batch != nil {
+		return r.publisher.PublishBatch(ctx, rec.Batch)
+	}
+	return r.publisher.PublishEvent(ctx, rec.Event)
+}
+
+func (rec record) eventType() string {
+	if rec.Batch != nil {
+		return kafka.BatchEventType
+	}
+	return rec.Event.EventType
+}
+
+// retryDelay - экспоненциальная задержка: 1s, 2s, 4s ... но не больше maxRetryDelay
+func retryDelay(attempts int) time.Duration {
+	if attempts > 16 {
+		return maxRetryDelay
+	}
+	delay := time.Second << uint(attempts)
+	if delay > maxRetryDelay {
+		return maxRetryDelay
+	}
+	return delay
+}
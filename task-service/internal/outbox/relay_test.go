@@ -0,0 +1,83 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"task-service/internal/testdb"
+	events "task-service/proto/events"
+)
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{3, 8 * time.Second},
+		{8, 256 * time.Second},
+		{9, maxRetryDelay}, // 512s > 5m
+		{16, maxRetryDelay},
+		{17, maxRetryDelay},
+		{100, maxRetryDelay}, // без переполнения сдвига
+	}
+	for _, tt := range tests {
+		if got := retryDelay(tt.attempts); got != tt.want {
+			t.Errorf("retryDelay(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+// failingPublisher - Kafka недоступна
+type failingPublisher struct{}
+
+func (failingPublisher) PublishEvent(ctx context.Context, event *events.TaskEvent) error {
+	return errors.New("kafka is down")
+}
+
+func (failingPublisher) PublishBatch(ctx context.Context, batch *events.TaskBatchEvent) error {
+	return errors.New("kafka is down")
+}
+
+func TestRelay_ParksAfterMaxAttempts(t *testing.T) {
+	db := testdb.Open(t)
+	store := NewStore(db)
+	ctx := context.Background()
+	id := enqueueTestEvent(t, store)
+
+	relay := NewRelay(store, failingPublisher{}, time.Second)
+	relay.maxAttempts = 2
+
+	// первая неудача - повтор позже
+	rec := claimOne(t, store, id)
+	if err := relay.deliver(ctx, rec); err != nil {
+		t.Fatal(err)
+	}
+	row := readRow(t, db, id)
+	if row.attempts != 1 || row.dead || row.lastError != "kafka is down" {
+		t.Fatalf("expected a retry after the first failure, got %+v", row)
+	}
+	if !row.retryLater {
+		t.Fatal("expected next_attempt_at in the future")
+	}
+
+	// вторая - попытки исчерпаны, событие отложено и больше не забирается
+	expireLease(t, db, id)
+	rec = claimOne(t, store, id)
+	if rec.Attempts != 1 {
+		t.Fatalf("expected 1 attempt in the claimed record, got %d", rec.Attempts)
+	}
+	if err := relay.deliver(ctx, rec); err != nil {
+		t.Fatal(err)
+	}
+	if row := readRow(t, db, id); row.attempts != 2 || !row.dead {
+		t.Fatalf("expected the event to be parked after 2 attempts, got %+v", row)
+	}
+	expireLease(t, db, id)
+	if claimed(t, store, id) {
+		t.Fatal("parked event must not be claimed")
+	}
+}
@@ -0,0 +1,235 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"task-service/database"
+	"task-service/internal/kafka"
+	"task-service/internal/models"
+	events "task-service/proto/events"
+)
+
+// ChangesChannel - канал NOTIFY, в который Enqueue пишет id события из outbox.
+// NOTIFY транзакционный: слушатели узнают о событии только после коммита
+const ChangesChannel = "task_changes"
+
+// Store - таблица outbox
+type Store struct {
+	db *sql.DB
+}
+
+func NewStore(db *sql.DB) *Store {
+	return &Store{db: db}
+}
+
+// record - запись outbox: одиночное событие (Event) или пакет (Batch)
+type record struct {
+	ID       int64
+	Attempts int
+	Event    *events.TaskEvent
+	Batch    *events.TaskBatchEvent
+}
+
+type batchKey struct{}
+
+type batchState struct {
+	events []*events.TaskEvent
+}
+
+// Enqueue сохраняет событие; если в контексте есть транзакция - в ней же,
+// поэтому событие появится только вместе с изменением задачи.
+// Внутри Batch событие не пишется сразу, а копится в пакет
+func (s *Store) Enqueue(ctx context.Context, event *events.TaskEvent) error {
+	if state, ok := ctx.Value(batchKey{}).(*batchState); ok {
+		state.events = append(state.events, event)
+		return nil
+	}
+
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+	return s.insert(ctx, event.TaskId, event.EventType, payload)
+}
+
+// Batch выполняет fn, собирая поставленные в ней события в один TaskBatchEvent.
+// Вложенный Batch при ошибке fn отбрасывает свои события, а при успехе
+// передаёт их внешнему; внешний сохраняет пакет одной записью outbox
+func (s *Store) Batch(ctx context.Context, fn func(ctx context.Context) error) error {
+	parent, nested := ctx.Value(batchKey{}).(*batchState)
+	state := &batchState{}
+	if err := fn(context.WithValue(ctx, batchKey{}, state)); err != nil {
+		return err
+	}
+	if nested {
+		parent.events = append(parent.events, state.events...)
+		return nil
+	}
+	if len(state.events) == 0 {
+		return nil
+	}
+
+	payload, err := proto.Marshal(&events.TaskBatchEvent{Events: state.events})
+	if err != nil {
+		return fmt.Errorf("marshal batch: %w", err)
+	}
+	// пакет не относится к одной задаче
+	return s.insert(ctx, 0, kafka.BatchEventType, payload)
+}
+
+func (s *Store) insert(ctx context.Context, taskID int32, eventType string, payload []byte) error {
+	_, err := database.Conn(ctx, s.db).ExecContext(ctx,
+		`WITH ins AS (
+		     INSERT INTO outbox (task_id, event_type, payload) VALUES ($1, $2, $3) RETURNING id
+		 )
+		 SELECT pg_notify($4, id::text) FROM ins`,
+		taskID, eventType, payload, ChangesChannel)
+	if err != nil {
+		return fmt.Errorf("enqueue event: %w", err)
+	}
+	return nil
+}
+
+// Events возвращает события записи outbox по id: одно или все события пакета
+func (s *Store) Events(ctx context.Context, id int64) ([]*events.TaskEvent, error) {
+	rec := record{ID: id}
+	var eventType string
+	var payload []byte
+	err := database.Conn(ctx, s.db).QueryRowContext(ctx,
+		`SELECT event_type, payload FROM outbox WHERE id = $1`, id).Scan(&eventType, &payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := rec.unmarshal(eventType, payload); err != nil {
+		return nil, err
+	}
+	if rec.Batch != nil {
+		return rec.Batch.Events, nil
+	}
+	return []*events.TaskEvent{rec.Event}, nil
+}
+
+func (rec *record) unmarshal(eventType string, payload []byte) error {
+	var msg proto.Message
+	if eventType == kafka.BatchEventType {
+		rec.Batch = &events.TaskBatchEvent{}
+		msg = rec.Batch
+	} else {
+		rec.Event = &events.TaskEvent{}
+		msg = rec.Event
+	}
+	if err := proto.Unmarshal(payload, msg); err != nil {
+		return fmt.Errorf("outbox %d: unmarshal: %w", rec.ID, err)
+	}
+	return nil
+}
+
+// EnqueueTask сохраняет событие eventType по текущему состоянию задачи
+func (s *Store) EnqueueTask(ctx context.Context, eventType string, task *models.Task) error {
+	event := kafka.NewTaskEvent(eventType, int32(task.ID), task.Text, task.Status, task.UserID)
+	event.Priority = task.Priority.String()
+	event.Tags = task.Tags
+	event.Assignees = task.Assignees
+	event.Watchers = task.Watchers
+	if task.DueAt != nil {
+		event.DueAt = timestamppb.New(*task.DueAt)
+	}
+	if r := task.FailureReason; r != nil {
+		event.FailureReason = &events.FailureReason{
+			Code:      r.Code,
+			Validator: r.Validator,
+			Message:   r.Message,
+			Details:   r.Details,
+		}
+	}
+	return s.Enqueue(ctx, event)
+}
+
+// claim забирает готовые к отправке события в аренду: next_attempt_at сдвигается на lease,
+// и до её истечения их не заберёт ни этот, ни другой relay. Одним запросом, без долгой
+// транзакции: публикация идёт уже после коммита. Не отправленное за lease заберут снова
+func (s *Store) claim(ctx context.Context, limit int, lease time.Duration) ([]record, error) {
+	rows, err := database.Conn(ctx, s.db).QueryContext(ctx,
+		`UPDATE outbox SET next_attempt_at = NOW() + $2 * INTERVAL '1 millisecond'
+		 WHERE id IN (
+		     SELECT id FROM outbox
+		     WHERE sent_at IS NULL AND dead_at IS NULL AND next_attempt_at <= NOW()
+		     ORDER BY id
+		     LIMIT $1
+		     FOR UPDATE SKIP LOCKED
+		 )
+		 RETURNING id, attempts, event_type, payload`, limit, lease.Milliseconds())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var records []record
+	for rows.Next() {
+		var rec record
+		var eventType string
+		var payload []byte
+		if err := rows.Scan(&rec.ID, &rec.Attempts, &eventType, &payload); err != nil {
+			return nil, err
+		}
+		if err := rec.unmarshal(eventType, payload); err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// RETURNING не сохраняет порядок подзапроса
+	sort.Slice(records, func(i, j int) bool { return records[i].ID < records[j].ID })
+	return records, nil
+}
+
+func (s *Store) markSent(ctx context.Context, id int64) error {
+	_, err := database.Conn(ctx, s.db).ExecContext(ctx,
+		`UPDATE outbox SET sent_at = NOW(), attempts = attempts + 1, last_error = NULL WHERE id = $1 AND sent_at IS NULL`, id)
+	return err
+}
+
+func (s *Store) markFailed(ctx context.Context, id int64, cause error, retryIn time.Duration) error {
+	_, err := database.Conn(ctx, s.db).ExecContext(ctx,
+		`UPDATE outbox
+		 SET attempts = attempts + 1,
+		     last_error = $2,
+		     next_attempt_at = NOW() + $3 * INTERVAL '1 millisecond'
+		 WHERE id = $1 AND sent_at IS NULL`, id, cause.Error(), retryIn.Milliseconds())
+	return err
+}
+
+// markDead откладывает событие, исчерпавшее попытки: relay его больше не забирает.
+// Вернуть в очередь: UPDATE outbox SET dead_at = NULL, attempts = 0, next_attempt_at = NOW() WHERE id = ...
+func (s *Store) markDead(ctx context.Context, id int64, cause error) error {
+	_, err := database.Conn(ctx, s.db).ExecContext(ctx,
+		`UPDATE outbox SET attempts = attempts + 1, last_error = $2, dead_at = NOW() WHERE id = $1 AND sent_at IS NULL`,
+		id, cause.Error())
+	return err
+}
+
+// deleteOld удаляет до limit отправленных событий старше olderThan; withUnsent - и неотправленные
+// (когда relay не запущен, события нужны только WatchTasks). Отложенные (dead_at) не трогает
+func (s *Store) deleteOld(ctx context.Context, olderThan time.Duration, withUnsent bool, limit int) (int64, error) {
+	cond := `sent_at < NOW() - $1 * INTERVAL '1 millisecond'`
+	if withUnsent {
+		cond = `(sent_at < NOW() - $1 * INTERVAL '1 millisecond'
+		         OR (sent_at IS NULL AND dead_at IS NULL AND created_at < NOW() - $1 * INTERVAL '1 millisecond'))`
+	}
+	res, err := database.Conn(ctx, s.db).ExecContext(ctx,
+		`DELETE FROM outbox WHERE id IN (SELECT id FROM outbox WHERE `+cond+` ORDER BY id LIMIT $2)`,
+		olderThan.Milliseconds(), limit)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,228 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"task-service/internal/testdb"
+	events "task-service/proto/events"
+)
+
+// типы событий из контекста Batch; в базу ничего не пишется, если в контексте уже есть пакет
+func batchTypes(state *batchState) []string {
+	var types []string
+	for _, e := range state.events {
+		types = append(types, e.EventType)
+	}
+	return types
+}
+
+func TestBatch_Nesting(t *testing.T) {
+	s := NewStore(nil)
+	// внешний пакет уже открыт: вложенные Batch отдают события ему, а не в outbox
+	root := &batchState{}
+	ctx := context.WithValue(context.Background(), batchKey{}, root)
+	boom := errors.New("boom")
+
+	err := s.Batch(ctx, func(ctx context.Context) error {
+		s.Enqueue(ctx, &events.TaskEvent{EventType: "A"})
+		// удачный вложенный пакет - его события остаются
+		if err := s.Batch(ctx, func(ctx context.Context) error {
+			return s.Enqueue(ctx, &events.TaskEvent{EventType: "B"})
+		}); err != nil {
+			return err
+		}
+		// неудачный (как откаченный элемент runBatch) - события отбрасываются
+		if err := s.Batch(ctx, func(ctx context.Context) error {
+			s.Enqueue(ctx, &events.TaskEvent{EventType: "C"})
+			return boom
+		}); !errors.Is(err, boom) {
+			t.Fatalf("expected boom from the nested batch, got %v", err)
+		}
+		return s.Enqueue(ctx, &events.TaskEvent{EventType: "D"})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := batchTypes(root); len(got) != 3 || got[0] != "A" || got[1] != "B" || got[2] != "D" {
+		t.Fatalf("expected events [A B D], got %v", got)
+	}
+
+	// ошибка всего пакета - ни одного события
+	root.events = nil
+	err = s.Batch(ctx, func(ctx context.Context) error {
+		s.Enqueue(ctx, &events.TaskEvent{EventType: "E"})
+		return boom
+	})
+	if !errors.Is(err, boom) || len(root.events) != 0 {
+		t.Fatalf("expected boom and no events, got %v, %v", err, batchTypes(root))
+	}
+}
+
+// enqueueTestEvent кладёт событие с уникальным отрицательным task_id (задач с такими id нет)
+// и возвращает id записи; запись удаляется после теста
+func enqueueTestEvent(t *testing.T, s *Store) int64 {
+	t.Helper()
+	taskID := -rand.Int31n(1<<30) - 1
+	if err := s.Enqueue(context.Background(), &events.TaskEvent{EventType: "TEST", TaskId: taskID}); err != nil {
+		t.Fatal(err)
+	}
+	var id int64
+	if err := s.db.QueryRow(`SELECT id FROM outbox WHERE task_id = $1`, taskID).Scan(&id); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.db.Exec(`DELETE FROM outbox WHERE id = $1`, id) })
+	return id
+}
+
+// claimed - забрал ли claim запись id. База общая с другими тестами, поэтому
+// claim может вернуть и чужие записи - их аренда просто истечёт
+func claimed(t *testing.T, s *Store, id int64) bool {
+	t.Helper()
+	_, ok := tryClaim(t, s, id)
+	return ok
+}
+
+func claimOne(t *testing.T, s *Store, id int64) record {
+	t.Helper()
+	rec, ok := tryClaim(t, s, id)
+	if !ok {
+		t.Fatalf("event %d was not claimed", id)
+	}
+	return rec
+}
+
+func tryClaim(t *testing.T, s *Store, id int64) (record, bool) {
+	t.Helper()
+	records, err := s.claim(context.Background(), 10000, claimLease)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range records {
+		if rec.ID == id {
+			return rec, true
+		}
+	}
+	return record{}, false
+}
+
+// expireLease сдвигает next_attempt_at в прошлое, как будто аренда (или пауза перед повтором) истекла
+func expireLease(t *testing.T, db *sql.DB, id int64) {
+	t.Helper()
+	if _, err := db.Exec(`UPDATE outbox SET next_attempt_at = NOW() - INTERVAL '1 second' WHERE id = $1`, id); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type outboxRow struct {
+	attempts   int
+	lastError  string
+	dead       bool
+	sent       bool
+	retryLater bool
+}
+
+func readRow(t *testing.T, db *sql.DB, id int64) outboxRow {
+	t.Helper()
+	var row outboxRow
+	var lastError sql.NullString
+	err := db.QueryRow(
+		`SELECT attempts, last_error, dead_at IS NOT NULL, sent_at IS NOT NULL, next_attempt_at > NOW()
+		 FROM outbox WHERE id = $1`, id).Scan(&row.attempts, &lastError, &row.dead, &row.sent, &row.retryLater)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row.lastError = lastError.String
+	return row
+}
+
+func exists(t *testing.T, db *sql.DB, id int64) bool {
+	t.Helper()
+	var ok bool
+	if err := db.QueryRow(`SELECT EXISTS (SELECT 1 FROM outbox WHERE id = $1)`, id).Scan(&ok); err != nil {
+		t.Fatal(err)
+	}
+	return ok
+}
+
+func TestStore_ClaimLease(t *testing.T) {
+	db := testdb.Open(t)
+	s := NewStore(db)
+	id := enqueueTestEvent(t, s)
+
+	rec := claimOne(t, s, id)
+	if rec.Event == nil || rec.Event.EventType != "TEST" || rec.Attempts != 0 {
+		t.Fatalf("unexpected record %+v", rec)
+	}
+	// пока аренда не истекла, событие не заберёт ни этот, ни другой relay
+	if claimed(t, s, id) {
+		t.Fatal("event was claimed twice within the lease")
+	}
+
+	// relay упал, не отметив событие: после аренды его забирают снова
+	expireLease(t, db, id)
+	claimOne(t, s, id)
+
+	// отправленное больше не забирается
+	if err := s.markSent(context.Background(), id); err != nil {
+		t.Fatal(err)
+	}
+	expireLease(t, db, id)
+	if claimed(t, s, id) {
+		t.Fatal("sent event was claimed")
+	}
+	if row := readRow(t, db, id); !row.sent || row.attempts != 1 {
+		t.Fatalf("expected a sent event with 1 attempt, got %+v", row)
+	}
+}
+
+func TestStore_DeleteOld(t *testing.T) {
+	db := testdb.Open(t)
+	s := NewStore(db)
+	ctx := context.Background()
+
+	oldSent, freshSent := enqueueTestEvent(t, s), enqueueTestEvent(t, s)
+	oldUnsent, oldDead := enqueueTestEvent(t, s), enqueueTestEvent(t, s)
+	for _, id := range []int64{oldSent, freshSent} {
+		if err := s.markSent(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.markDead(ctx, oldDead, errors.New("kafka is down")); err != nil {
+		t.Fatal(err)
+	}
+	// состарить записи на два часа
+	for _, id := range []int64{oldSent, oldUnsent, oldDead} {
+		if _, err := db.Exec(
+			`UPDATE outbox SET created_at = created_at - INTERVAL '2 hours',
+			     sent_at = sent_at - INTERVAL '2 hours', dead_at = dead_at - INTERVAL '2 hours'
+			 WHERE id = $1`, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := s.deleteOld(ctx, time.Hour, false, 1000000); err != nil {
+		t.Fatal(err)
+	}
+	if exists(t, db, oldSent) {
+		t.Error("old sent event was not deleted")
+	}
+	if !exists(t, db, freshSent) || !exists(t, db, oldUnsent) || !exists(t, db, oldDead) {
+		t.Error("only old sent events must be deleted")
+	}
+
+	// без relay удаляются и старые неотправленные, но не отложенные
+	if _, err := s.deleteOld(ctx, time.Hour, true, 1000000); err != nil {
+		t.Fatal(err)
+	}
+	if exists(t, db, oldUnsent) {
+		t.Error("old unsent event was not deleted")
+	}
+	if !exists(t, db, freshSent) || !exists(t, db, oldDead) {
+		t.Error("fresh and parked events must be kept")
+	}
+}
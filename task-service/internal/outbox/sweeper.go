@@ -0,0 +1,77 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// за один запрос удаляется не больше стольких событий, остальные - следующим запросом
+const sweepBatch = 1000
+
+// Leader - только лидер чистит outbox (leader.Elector)
+type Leader interface {
+	IsLeader() bool
+}
+
+// Sweeper удаляет из outbox события старше retention: отправленные, а если relay не запущен
+// (withUnsent) - и неотправленные. Отложенные после maxAttempts остаются до разбора
+type Sweeper struct {
+	store      *Store
+	leader     Leader
+	retention  time.Duration
+	interval   time.Duration
+	withUnsent bool
+}
+
+func NewSweeper(store *Store, leader Leader, retention, interval time.Duration, withUnsent bool) *Sweeper {
+	return &Sweeper{
+		store:      store,
+		leader:     leader,
+		retention:  retention,
+		interval:   interval,
+		withUnsent: withUnsent,
+	}
+}
+
+func (s *Sweeper) Start(ctx context.Context) {
+	go func() {
+		log.Printf("[outbox] sweeper started, retention %s", s.retention)
+		defer log.Println("[outbox] sweeper stopped")
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if s.leader != nil && !s.leader.IsLeader() {
+					continue
+				}
+				s.sweep(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *Sweeper) sweep(ctx context.Context) {
+	var total int64
+	for {
+		dbCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		n, err := s.store.deleteOld(dbCtx, s.retention, s.withUnsent, sweepBatch)
+		cancel()
+		if err != nil {
+			log.Printf("[outbox] sweep error: %v", err)
+			break
+		}
+		total += n
+		if n < sweepBatch {
+			break
+		}
+	}
+	if total > 0 {
+		log.Printf("[outbox] deleted %d old events", total)
+	}
+}
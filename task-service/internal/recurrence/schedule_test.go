@@ -0,0 +1,73 @@
+package recurrence
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSchedule_Next(t *testing.T) {
+	// среда, 15 января 2025
+	from := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC)
+
+	cases := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, 1, 15, 10, 31, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2025, 1, 16, 10, 30, 0, 0, time.UTC)},
+		{"@daily", time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, 1, 15, 10, 45, 0, 0, time.UTC)},
+		{"0 9 * * mon-fri", time.Date(2025, 1, 16, 9, 0, 0, 0, time.UTC)},
+		{"0 9 * * 7", time.Date(2025, 1, 19, 9, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+		// день месяца и день недели заданы оба - подходит любой
+		{"0 12 20 * fri", time.Date(2025, 1, 17, 12, 0, 0, 0, time.UTC)},
+		{"0 0 1 jan *", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, c := range cases {
+		s, err := Parse(c.expr, nil)
+		if err != nil {
+			t.Fatalf("%q: %v", c.expr, err)
+		}
+		if got := s.Next(from); !got.Equal(c.want) {
+			t.Errorf("%q: next after %s = %s, want %s", c.expr, from, got, c.want)
+		}
+	}
+}
+
+func TestSchedule_NextInLocation(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+	s, err := Parse("0 9 * * *", loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 07:00 UTC = 10:00 MSK, 9 утра по Москве уже прошло
+	got := s.Next(time.Date(2025, 1, 15, 7, 0, 0, 0, time.UTC))
+	want := time.Date(2025, 1, 16, 6, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Fatalf("next = %s, want %s", got, want)
+	}
+}
+
+func TestSchedule_NeverFires(t *testing.T) {
+	s, err := Parse("0 0 30 2 *", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := s.Next(time.Now()); !got.IsZero() {
+		t.Fatalf("expected no next run, got %s", got)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "* * * foo *"} {
+		if _, err := Parse(expr, nil); !errors.Is(err, ErrInvalidSchedule) {
+			t.Errorf("%q: expected ErrInvalidSchedule, got %v", expr, err)
+		}
+	}
+}
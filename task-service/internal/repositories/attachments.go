@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"task-service/database"
+	"task-service/internal/models"
+)
+
+// ErrQuotaExceeded - вложение не помещается в квоту пользователя
+var ErrQuotaExceeded = errors.New("attachment quota exceeded")
+
+type AttachmentRepository interface {
+	// Create сохраняет метаданные, если суммарный размер вложений пользователя
+	// не превысит quota байт (quota <= 0 - без ограничения)
+	Create(ctx context.Context, attachment *models.Attachment, quota int64) error
+	GetByID(ctx context.Context, id int) (*models.Attachment, error)
+	ListByTask(ctx context.Context, taskID int) ([]models.Attachment, error)
+	UsedBytes(ctx context.Context, userID string) (int64, error)
+	Delete(ctx context.Context, id int) (bool, error)
+}
+
+type attachmentRepository struct {
+	db *sql.DB
+}
+
+func NewAttachmentRepository(db *sql.DB) AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+const attachmentColumns = `id, task_id, user_id, name, content_type, size, checksum, storage_key, created_at`
+
+func scanAttachment(row rowScanner) (models.Attachment, error) {
+	var a models.Attachment
+	err := row.Scan(&a.ID, &a.TaskID, &a.UserID, &a.Name, &a.ContentType, &a.Size, &a.Checksum, &a.StorageKey, &a.CreatedAt)
+	return a, err
+}
+
+func (r *attachmentRepository) Create(ctx context.Context, attachment *models.Attachment, quota int64) error {
+	return database.WithinTx(ctx, r.db, func(ctx context.Context) error {
+		conn := database.Conn(ctx, r.db)
+
+		// параллельные загрузки одного пользователя проверяют квоту по очереди
+		if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('attachments:' || $1))`, attachment.UserID); err != nil {
+			return err
+		}
+		if quota > 0 {
+			used, err := r.UsedBytes(ctx, attachment.UserID)
+			if err != nil {
+				return err
+			}
+			if used+attachment.Size > quota {
+				return ErrQuotaExceeded
+			}
+		}
+
+		return conn.QueryRowContext(ctx,
+			`INSERT INTO task_attachments (task_id, user_id, name, content_type, size, checksum, storage_key)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7)
+			 RETURNING id, created_at`,
+			attachment.TaskID, attachment.UserID, attachment.Name, attachment.ContentType,
+			attachment.Size, attachment.Checksum, attachment.StorageKey,
+		).Scan(&attachment.ID, &attachment.CreatedAt)
+	})
+}
+
+func (r *attachmentRepository) GetByID(ctx context.Context, id int) (*models.Attachment, error) {
+	a, err := scanAttachment(database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+attachmentColumns+` FROM task_attachments WHERE id = $1`, id))
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *attachmentRepository) ListByTask(ctx context.Context, taskID int) ([]models.Attachment, error) {
+	rows, err := database.Conn(ctx, r.db).QueryContext(ctx,
+		`SELECT `+attachmentColumns+` FROM task_attachments WHERE task_id = $1 ORDER BY created_at, id`, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var attachments []models.Attachment
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, a)
+	}
+	return attachments, rows.Err()
+}
+
+// UsedBytes - сколько места занимают вложения пользователя
+func (r *attachmentRepository) UsedBytes(ctx context.Context, userID string) (int64, error) {
+	var used int64
+	err := database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT COALESCE(SUM(size), 0) FROM task_attachments WHERE user_id = $1`, userID).Scan(&used)
+	return used, err
+}
+
+// Delete удаляет только метаданные; блоб удаляет вызывающий
+func (r *attachmentRepository) Delete(ctx context.Context, id int) (bool, error) {
+	res, err := database.Conn(ctx, r.db).ExecContext(ctx, `DELETE FROM task_attachments WHERE id = $1`, id)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"task-service/database"
+	"task-service/internal/models"
+)
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment *models.Comment) error
+	GetByID(ctx context.Context, id int) (*models.Comment, error)
+	List(ctx context.Context, taskID, page, limit int) ([]models.Comment, int, error)
+	Update(ctx context.Context, id int, body string) (*models.Comment, error)
+	Delete(ctx context.Context, id int) (bool, error)
+}
+
+type commentRepository struct {
+	db *sql.DB
+}
+
+func NewCommentRepository(db *sql.DB) CommentRepository {
+	return &commentRepository{db: db}
+}
+
+const commentColumns = `id, task_id, user_id, body, created_at, updated_at`
+
+func scanComment(row rowScanner) (models.Comment, error) {
+	var c models.Comment
+	var updatedAt sql.NullTime
+	if err := row.Scan(&c.ID, &c.TaskID, &c.UserID, &c.Body, &c.CreatedAt, &updatedAt); err != nil {
+		return c, err
+	}
+	if updatedAt.Valid {
+		c.UpdatedAt = &updatedAt.Time
+	}
+	return c, nil
+}
+
+// Create пишет комментарий (в транзакции из контекста, если она есть)
+func (r *commentRepository) Create(ctx context.Context, comment *models.Comment) error {
+	err := database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`INSERT INTO task_comments (task_id, user_id, body) VALUES ($1, $2, $3) RETURNING id, created_at`,
+		comment.TaskID, comment.UserID, comment.Body,
+	).Scan(&comment.ID, &comment.CreatedAt)
+	return err
+}
+
+func (r *commentRepository) GetByID(ctx context.Context, id int) (*models.Comment, error) {
+	c, err := scanComment(database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+commentColumns+` FROM task_comments WHERE id = $1`, id))
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// List - комментарии задачи от старых к новым
+func (r *commentRepository) List(ctx context.Context, taskID, page, limit int) ([]models.Comment, int, error) {
+	conn := database.Conn(ctx, r.db)
+
+	var total int
+	if err := conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM task_comments WHERE task_id = $1`, taskID).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	if limit <= 0 {
+		limit = 50
+	}
+	if page < 1 {
+		page = 1
+	}
+	rows, err := conn.QueryContext(ctx,
+		`SELECT `+commentColumns+` FROM task_comments
+		 WHERE task_id = $1
+		 ORDER BY created_at, id
+		 LIMIT $2 OFFSET $3`,
+		taskID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var comments []models.Comment
+	for rows.Next() {
+		c, err := scanComment(rows)
+		if err != nil {
+			return nil, 0, err
+		}
+		comments = append(comments, c)
+	}
+	return comments, total, rows.Err()
+}
+
+func (r *commentRepository) Update(ctx context.Context, id int, body string) (*models.Comment, error) {
+	c, err := scanComment(database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`UPDATE task_comments SET body = $2, updated_at = $3 WHERE id = $1 RETURNING `+commentColumns,
+		id, body, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Delete - false, если комментария не было
+func (r *commentRepository) Delete(ctx context.Context, id int) (bool, error) {
+	res, err := database.Conn(ctx, r.db).ExecContext(ctx, `DELETE FROM task_comments WHERE id = $1`, id)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
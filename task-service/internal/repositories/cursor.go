@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"task-service/internal/models"
+)
+
+// TaskPage - страница задач из List или Search
+type TaskPage struct {
+	Tasks []models.Task `json:"tasks"`
+	// Total - сколько всего задач подходит под фильтр; -1, если не считали (SkipTotal)
+	Total int `json:"total"`
+	// NextPageToken - курсор следующей страницы; пусто, если страница последняя
+	NextPageToken string `json:"next_page_token,omitempty"`
+	// Hits - только у Search: релевантность и фрагмент для каждой из Tasks
+	Hits []SearchHit `json:"hits,omitempty"`
+	// Facets - только у Search, если запрошены в SearchFilter.Facets
+	Facets []Facet `json:"facets,omitempty"`
+}
+
+// pageCursor - позиция keyset-пагинации: ключ сортировки и id последней задачи страницы.
+// Клиенту уходит непрозрачной строкой (base64 от JSON)
+type pageCursor struct {
+	Sort string    `json:"s"`
+	Time time.Time `json:"t"`           // created_at / deleted_at
+	Rank float64   `json:"r,omitempty"` // релевантность в Search
+	ID   int       `json:"id"`
+}
+
+func (c pageCursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor разбирает page_token; курсор другой сортировки - ошибка фильтра
+func decodeCursor(token, sort string) (pageCursor, error) {
+	var c pageCursor
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(data, &c)
+	}
+	if err != nil || c.ID <= 0 {
+		return c, fmt.Errorf("%w: invalid page_token", ErrInvalidFilter)
+	}
+	if c.Sort != sort {
+		return c, fmt.Errorf("%w: page_token was issued for another sort order", ErrInvalidFilter)
+	}
+	return c, nil
+}
+
+// listCursor - курсор после задачи task при сортировке List по колонке column;
+// sortKey - колонка с направлением, по нему курсор привязан к порядку выдачи
+func listCursor(task *models.Task, column, sortKey string) pageCursor {
+	c := pageCursor{Sort: sortKey, Time: task.CreatedAt, ID: task.ID}
+	if column == "deleted_at" && task.DeletedAt != nil {
+		c.Time = *task.DeletedAt
+	}
+	return c
+}
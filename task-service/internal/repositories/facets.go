@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"task-service/database"
+)
+
+// Фасеты поиска (SearchFilter.Facets)
+const (
+	FacetStatus  = "status"
+	FacetTag     = "tag"
+	FacetCreated = "created" // по дате создания, шаг - SearchFilter.CreatedBucket
+)
+
+// maxFacetBuckets - сколько значений фасета отдаём: самые частые теги, последние даты
+const maxFacetBuckets = 50
+
+// createdBuckets - шаги фасета created (единицы date_trunc)
+var createdBuckets = map[string]bool{"day": true, "week": true, "month": true}
+
+// Facet - сколько найденных задач приходится на каждое значение поля
+type Facet struct {
+	Name    string        `json:"name"`
+	Buckets []FacetBucket `json:"buckets"`
+}
+
+// FacetBucket - значение фасета; у created - начало периода (YYYY-MM-DD)
+type FacetBucket struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// facetQuery - запрос подсчёта фасета name по задачам, подходящим под where
+func facetQuery(name, where, createdBucket string) (string, error) {
+	switch name {
+	case FacetStatus:
+		return `SELECT status, COUNT(*) FROM "Tasks" WHERE ` + where + `
+		        GROUP BY status ORDER BY COUNT(*) DESC, status LIMIT ` + fmt.Sprint(maxFacetBuckets), nil
+	case FacetTag:
+		// задача с несколькими тегами попадает в каждый
+		return `SELECT tg.name, COUNT(*) FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id
+		        WHERE tt.task_id IN (SELECT id FROM "Tasks" WHERE ` + where + `)
+		        GROUP BY tg.name ORDER BY COUNT(*) DESC, tg.name LIMIT ` + fmt.Sprint(maxFacetBuckets), nil
+	case FacetCreated:
+		if createdBucket == "" {
+			createdBucket = "month"
+		}
+		if !createdBuckets[createdBucket] {
+			return "", fmt.Errorf("%w: unsupported created bucket %q (day, week, month)", ErrInvalidFilter, createdBucket)
+		}
+		return `SELECT to_char(date_trunc('` + createdBucket + `', created_at), 'YYYY-MM-DD') AS bucket, COUNT(*)
+		        FROM "Tasks" WHERE ` + where + `
+		        GROUP BY bucket ORDER BY bucket DESC LIMIT ` + fmt.Sprint(maxFacetBuckets), nil
+	}
+	return "", fmt.Errorf("%w: unsupported facet %q (status, tag, created)", ErrInvalidFilter, name)
+}
+
+// facets считает запрошенные фасеты по тем же условиям, что и выдача поиска
+func (r *taskRepository) facets(ctx context.Context, conn database.DBTX, queries map[string]string, names []string, args []interface{}) ([]Facet, error) {
+	var result []Facet
+	for _, name := range names {
+		rows, err := conn.QueryContext(ctx, queries[name], args...)
+		if err != nil {
+			return nil, fmt.Errorf("facet %s query error: %w", name, err)
+		}
+		facet := Facet{Name: name, Buckets: []FacetBucket{}}
+		for rows.Next() {
+			var b FacetBucket
+			if err := rows.Scan(&b.Value, &b.Count); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("facet %s scan error: %w", name, err)
+			}
+			facet.Buckets = append(facet.Buckets, b)
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, fmt.Errorf("facet %s query error: %w", name, err)
+		}
+		result = append(result, facet)
+	}
+	return result, nil
+}
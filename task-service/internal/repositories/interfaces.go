@@ -10,12 +10,32 @@ type TaskRepository interface {
 	GetAll(ctx context.Context) ([]models.Task, error)
 	GetByStatus(ctx context.Context, status string) ([]models.Task, error)
 	GetByID(ctx context.Context, id int) (*models.Task, error)
+	GetForUpdate(ctx context.Context, id int) (*models.Task, error)
 	Create(ctx context.Context, task *models.Task) (int, error)
 	Update(ctx context.Context, task *models.Task) error
 	UpdateStatus(ctx context.Context, id int, status string, startedAt, endedAt *time.Time) error
 	Delete(ctx context.Context, id int) error
-	List(ctx context.Context, filter TaskFilter) ([]models.Task, int, error)
-	Search(ctx context.Context, query, userID string, page, limit int) ([]models.Task, int, error)
+	Restore(ctx context.Context, id int) error
+	GetTrashed(ctx context.Context, id int) (*models.Task, error)
+	GetExpiredTrash(ctx context.Context, deletedBefore time.Time, limit int) ([]models.Task, error)
+	Purge(ctx context.Context, id int, deletedBefore time.Time) (bool, error)
+	List(ctx context.Context, filter TaskFilter) (*TaskPage, error)
+	Search(ctx context.Context, filter SearchFilter) (*TaskPage, error)
 	IncrementAttempts(ctx context.Context, id int) error
 	GetActiveTasksCount(ctx context.Context, userID string) (int, error)
+	GetActiveTasksCountInProject(ctx context.Context, userID string, projectID int) (int, error)
+	SetFailureReason(ctx context.Context, id int, reason *models.ValidationReason) error
+	CountCreatedSince(ctx context.Context, userID string, since time.Time) (int, error)
+	CountDuplicates(ctx context.Context, userID, text string, excludeID int) (int, error)
+	GetHistory(ctx context.Context, taskID int) ([]models.TaskTransition, error)
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	GetOverdue(ctx context.Context, now time.Time, excludeStatuses []string) ([]models.Task, error)
+	MarkOverdue(ctx context.Context, id int, priority models.Priority) (bool, error)
+	CountOpenBlockers(ctx context.Context, taskID int, doneStatuses []string) (int, error)
+	AddDependency(ctx context.Context, taskID, blockedByID int) error
+	RemoveDependency(ctx context.Context, taskID, blockedByID int) (bool, error)
+	GetSubtree(ctx context.Context, rootID int) ([]models.Task, error)
+	GetBlockers(ctx context.Context, taskIDs []int) (map[int][]models.Task, error)
+	AddMember(ctx context.Context, taskID int, userID, role string) (bool, error)
+	RemoveMember(ctx context.Context, taskID int, userID, role string) (bool, error)
 }
\ No newline at end of file
@@ -0,0 +1,207 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"task-service/database"
+	"task-service/internal/models"
+)
+
+// ErrProjectNotEmpty - в проекте ещё есть задачи
+var ErrProjectNotEmpty = errors.New("project has tasks")
+
+type ProjectRepository interface {
+	// Create сохраняет проект и делает его владельца участником с ролью owner
+	Create(ctx context.Context, project *models.Project) error
+	GetByID(ctx context.Context, id int) (*models.Project, error)
+	ListForUser(ctx context.Context, userID string) ([]models.Project, error)
+	Update(ctx context.Context, project *models.Project) error
+	Delete(ctx context.Context, id int) (bool, error)
+	GetSettings(ctx context.Context, id int) (*models.ProjectSettings, error)
+
+	// SetMember добавляет участника или меняет его роль
+	SetMember(ctx context.Context, projectID int, userID, role string) error
+	RemoveMember(ctx context.Context, projectID int, userID string) (bool, error)
+	// MemberRole - роль пользователя в проекте, "" - не участник
+	MemberRole(ctx context.Context, projectID int, userID string) (string, error)
+}
+
+type projectRepository struct {
+	db *sql.DB
+}
+
+func NewProjectRepository(db *sql.DB) ProjectRepository {
+	return &projectRepository{db: db}
+}
+
+const projectColumns = `id, name, owner_id, settings, created_at, updated_at`
+
+func scanProject(row rowScanner) (models.Project, error) {
+	var p models.Project
+	var settings []byte
+	if err := row.Scan(&p.ID, &p.Name, &p.OwnerID, &settings, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		return p, err
+	}
+	if err := json.Unmarshal(settings, &p.Settings); err != nil {
+		return p, err
+	}
+	return p, nil
+}
+
+func (r *projectRepository) Create(ctx context.Context, project *models.Project) error {
+	settings, err := json.Marshal(project.Settings)
+	if err != nil {
+		return err
+	}
+	return database.WithinTx(ctx, r.db, func(ctx context.Context) error {
+		err := database.Conn(ctx, r.db).QueryRowContext(ctx,
+			`INSERT INTO projects (name, owner_id, settings) VALUES ($1, $2, $3) RETURNING id, created_at, updated_at`,
+			project.Name, project.OwnerID, settings,
+		).Scan(&project.ID, &project.CreatedAt, &project.UpdatedAt)
+		if err != nil {
+			return err
+		}
+		project.Members = []models.ProjectMember{{UserID: project.OwnerID, Role: models.ProjectOwner}}
+		return r.SetMember(ctx, project.ID, project.OwnerID, models.ProjectOwner)
+	})
+}
+
+// GetByID - проект вместе с участниками
+func (r *projectRepository) GetByID(ctx context.Context, id int) (*models.Project, error) {
+	conn := database.Conn(ctx, r.db)
+	p, err := scanProject(conn.QueryRowContext(ctx, `SELECT `+projectColumns+` FROM projects WHERE id = $1`, id))
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := conn.QueryContext(ctx,
+		`SELECT user_id, role FROM project_members WHERE project_id = $1 ORDER BY created_at, user_id`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var m models.ProjectMember
+		if err := rows.Scan(&m.UserID, &m.Role); err != nil {
+			return nil, err
+		}
+		p.Members = append(p.Members, m)
+	}
+	return &p, rows.Err()
+}
+
+// ListForUser - проекты, в которых пользователь участник
+func (r *projectRepository) ListForUser(ctx context.Context, userID string) ([]models.Project, error) {
+	rows, err := database.Conn(ctx, r.db).QueryContext(ctx,
+		`SELECT `+projectColumns+` FROM projects
+		 WHERE id IN (SELECT project_id FROM project_members WHERE user_id = $1)
+		 ORDER BY name, id`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var projects []models.Project
+	for rows.Next() {
+		p, err := scanProject(rows)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, p)
+	}
+	return projects, rows.Err()
+}
+
+func (r *projectRepository) Update(ctx context.Context, project *models.Project) error {
+	settings, err := json.Marshal(project.Settings)
+	if err != nil {
+		return err
+	}
+	res, err := database.Conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE projects SET name = $2, settings = $3, updated_at = NOW() WHERE id = $1`,
+		project.ID, project.Name, settings)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		if err == nil {
+			err = sql.ErrNoRows
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete удаляет пустой проект; если в нём есть задачи - ErrProjectNotEmpty
+func (r *projectRepository) Delete(ctx context.Context, id int) (bool, error) {
+	var deleted bool
+	err := database.WithinTx(ctx, r.db, func(ctx context.Context) error {
+		conn := database.Conn(ctx, r.db)
+		// блокируем проект, чтобы в него не добавили задачу между проверкой и удалением
+		var locked int
+		err := conn.QueryRowContext(ctx, `SELECT id FROM projects WHERE id = $1 FOR UPDATE`, id).Scan(&locked)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var hasTasks bool
+		if err := conn.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM "Tasks" WHERE project_id = $1)`, id).Scan(&hasTasks); err != nil {
+			return err
+		}
+		if hasTasks {
+			return ErrProjectNotEmpty
+		}
+		if _, err := conn.ExecContext(ctx, `DELETE FROM projects WHERE id = $1`, id); err != nil {
+			return err
+		}
+		deleted = true
+		return nil
+	})
+	return deleted, err
+}
+
+func (r *projectRepository) GetSettings(ctx context.Context, id int) (*models.ProjectSettings, error) {
+	var data []byte
+	if err := database.Conn(ctx, r.db).QueryRowContext(ctx, `SELECT settings FROM projects WHERE id = $1`, id).Scan(&data); err != nil {
+		return nil, err
+	}
+	var settings models.ProjectSettings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (r *projectRepository) SetMember(ctx context.Context, projectID int, userID, role string) error {
+	_, err := database.Conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO project_members (project_id, user_id, role) VALUES ($1, $2, $3)
+		 ON CONFLICT (project_id, user_id) DO UPDATE SET role = EXCLUDED.role`,
+		projectID, userID, role)
+	return err
+}
+
+func (r *projectRepository) RemoveMember(ctx context.Context, projectID int, userID string) (bool, error) {
+	res, err := database.Conn(ctx, r.db).ExecContext(ctx,
+		`DELETE FROM project_members WHERE project_id = $1 AND user_id = $2`, projectID, userID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (r *projectRepository) MemberRole(ctx context.Context, projectID int, userID string) (string, error) {
+	var role string
+	err := database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT role FROM project_members WHERE project_id = $1 AND user_id = $2`, projectID, userID).Scan(&role)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return role, err
+}
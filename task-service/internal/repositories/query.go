@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// queryBuilder собирает условия WHERE с нумерованными плейсхолдерами. Значения
+// фильтров идут только аргументами; в текст запроса попадают лишь колонки и
+// выражения из кода (белые списки вроде sortColumns)
+type queryBuilder struct {
+	conds []string
+	args  []interface{}
+}
+
+// arg добавляет аргумент и возвращает его плейсхолдер ($n)
+func (b *queryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// where добавляет условие; каждый "?" в cond заменяется плейсхолдером следующего из args
+func (b *queryBuilder) where(cond string, args ...interface{}) {
+	if n := strings.Count(cond, "?"); n != len(args) {
+		panic(fmt.Sprintf("queryBuilder: %d placeholders for %d args in %q", n, len(args), cond))
+	}
+	var sb strings.Builder
+	i := 0
+	for _, part := range strings.SplitAfter(cond, "?") {
+		if strings.HasSuffix(part, "?") {
+			sb.WriteString(part[:len(part)-1])
+			sb.WriteString(b.arg(args[i]))
+			i++
+			continue
+		}
+		sb.WriteString(part)
+	}
+	b.conds = append(b.conds, sb.String())
+}
+
+// sql - условия через AND; без условий - TRUE
+func (b *queryBuilder) sql() string {
+	if len(b.conds) == 0 {
+		return "TRUE"
+	}
+	return strings.Join(b.conds, " AND ")
+}
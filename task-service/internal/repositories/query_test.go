@@ -0,0 +1,144 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"task-service/internal/models"
+)
+
+func TestQueryBuilder_Placeholders(t *testing.T) {
+	b := &queryBuilder{}
+	q := b.arg("молоко")
+	b.where("search_vector @@ plainto_tsquery('russian', " + q + ")")
+	for i := 0; i < 10; i++ {
+		b.where("attempts <> ?", i)
+	}
+	b.where("(created_at, id) < (?, ?)", "2025-01-01", 42)
+
+	want := "search_vector @@ plainto_tsquery('russian', $1)" +
+		" AND attempts <> $2 AND attempts <> $3 AND attempts <> $4 AND attempts <> $5 AND attempts <> $6" +
+		" AND attempts <> $7 AND attempts <> $8 AND attempts <> $9 AND attempts <> $10 AND attempts <> $11" +
+		" AND (created_at, id) < ($12, $13)"
+	if got := b.sql(); got != want {
+		t.Errorf("sql:\n got %s\nwant %s", got, want)
+	}
+	if len(b.args) != 13 || b.args[12] != 42 {
+		t.Errorf("args = %v", b.args)
+	}
+	if got := b.arg(100); got != "$14" {
+		t.Errorf("arg = %s, want $14", got)
+	}
+}
+
+func TestQueryBuilder_Empty(t *testing.T) {
+	if got := (&queryBuilder{}).sql(); got != "TRUE" {
+		t.Errorf("sql = %q, want TRUE", got)
+	}
+}
+
+func TestQueryBuilder_ArgCountMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for placeholder/arg mismatch")
+		}
+	}()
+	(&queryBuilder{}).where("a = ? AND b = ?", 1)
+}
+
+func TestLikeEscaper(t *testing.T) {
+	if got := likeEscaper.Replace(`50%_off\`); got != `50\%\_off\\` {
+		t.Errorf("escaped = %s", got)
+	}
+}
+
+func TestPrefixQuery(t *testing.T) {
+	cases := map[string]string{
+		"мол":            "мол:*",
+		"купить  мол":    "купить:* & мол:*",
+		"a|b & !c:* 'd'": "a:* & b:* & c:* & d:*",
+		" -- ":           "",
+		"v1.2 relea":     "v1:* & 2:* & relea:*",
+	}
+	for input, want := range cases {
+		if got := prefixQuery(input); got != want {
+			t.Errorf("prefixQuery(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestFacetQuery(t *testing.T) {
+	for _, name := range []string{FacetStatus, FacetTag, FacetCreated} {
+		if _, err := facetQuery(name, "TRUE", ""); err != nil {
+			t.Errorf("facetQuery(%s): %v", name, err)
+		}
+	}
+	if _, err := facetQuery("priority", "TRUE", ""); !errors.Is(err, ErrInvalidFilter) {
+		t.Errorf("unknown facet: err = %v, want ErrInvalidFilter", err)
+	}
+	if _, err := facetQuery(FacetCreated, "TRUE", "year'); DROP"); !errors.Is(err, ErrInvalidFilter) {
+		t.Errorf("unknown bucket: err = %v, want ErrInvalidFilter", err)
+	}
+}
+
+func TestTaskFilterConditions(t *testing.T) {
+	minAttempts := 2
+	b := &queryBuilder{}
+	b.where("deleted_at IS NULL")
+	f := TaskFilter{Status: "NEW", Statuses: []string{"DONE"}, MinAttempts: &minAttempts, ProjectID: 7, UserID: "ignored"}
+	f.conditions(b)
+
+	want := "deleted_at IS NULL AND status = ANY($1) AND attempts >= $2 AND project_id = $3"
+	if got := b.sql(); got != want {
+		t.Errorf("sql:\n got %s\nwant %s", got, want)
+	}
+	if len(b.args) != 3 {
+		t.Errorf("args = %v", b.args)
+	}
+}
+
+func TestReadDB_ReadYourWrites(t *testing.T) {
+	primary, _ := sql.Open("postgres", "postgres://primary/db")
+	replica, _ := sql.Open("postgres", "postgres://replica/db")
+	defer primary.Close()
+	defer replica.Close()
+
+	alice := WithActor(context.Background(), models.Actor{Type: models.ActorUser, ID: "alice"}, "")
+	bob := WithActor(context.Background(), models.Actor{Type: models.ActorUser, ID: "bob"}, "")
+
+	if got := NewTaskRepository(primary, nil, nil).readDB(alice); got != primary {
+		t.Fatal("without replica reads must go to primary")
+	}
+
+	r := NewTaskRepository(primary, nil, nil).WithReplica(replica, time.Minute)
+	if got := r.readDB(alice); got != replica {
+		t.Fatal("reads must go to replica")
+	}
+
+	r.wrote(alice)
+	if got := r.readDB(alice); got != primary {
+		t.Fatal("alice just wrote: alice's reads must go to primary")
+	}
+	if got := r.readDB(bob); got != replica {
+		t.Fatal("bob did not write: bob's reads must go to replica")
+	}
+	if got := r.readDB(context.Background()); got != replica {
+		t.Fatal("reads without user must go to replica")
+	}
+
+	r.writers.mark("alice", time.Now().Add(-time.Minute))
+	if got := r.readDB(alice); got != replica {
+		t.Fatal("window elapsed: reads must go to replica")
+	}
+}
+
+func TestEscapeSnippet(t *testing.T) {
+	got := escapeSnippet("купить \x02молоко\x03 <script>alert(1)</script> & \"хлеб\"")
+	want := `купить <mark>молоко</mark> &lt;script&gt;alert(1)&lt;/script&gt; &amp; &#34;хлеб&#34;`
+	if got != want {
+		t.Errorf("escapeSnippet() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"task-service/database"
+	"task-service/internal/models"
+)
+
+// maxRecentWriters - после стольких записей в recentWriters из неё выбрасываются устаревшие
+const maxRecentWriters = 1024
+
+// recentWriters - когда пользователь последний раз менял задачи (для read-your-writes)
+type recentWriters struct {
+	mu     sync.Mutex
+	window time.Duration
+	last   map[string]time.Time
+}
+
+func (w *recentWriters) mark(userID string, now time.Time) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.last) >= maxRecentWriters {
+		for id, at := range w.last {
+			if now.Sub(at) >= w.window {
+				delete(w.last, id)
+			}
+		}
+	}
+	w.last[userID] = now
+}
+
+func (w *recentWriters) recent(userID string, now time.Time) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	at, ok := w.last[userID]
+	return ok && now.Sub(at) < w.window
+}
+
+// WithReplica направляет тяжёлые чтения (List, Search, GetHistory) на реплику.
+// readYourWrites - сколько после своей записи пользователь читает с primary, пока реплика
+// догоняет; 0 - сразу с реплики. Учитываются записи только этого процесса
+func (r *taskRepository) WithReplica(replica *sql.DB, readYourWrites time.Duration) *taskRepository {
+	r.replica = replica
+	if readYourWrites > 0 {
+		r.writers = &recentWriters{window: readYourWrites, last: make(map[string]time.Time)}
+	}
+	return r
+}
+
+// readDB - пул для чтения: реплика, если она есть, транзакция не открыта и пользователь
+// из контекста (WithActor) только что ничего не менял, иначе primary
+func (r *taskRepository) readDB(ctx context.Context) *sql.DB {
+	if r.replica == nil {
+		return r.db
+	}
+	if database.InTx(ctx) {
+		return r.db
+	}
+	if r.recentWriter(ctx) {
+		return r.db
+	}
+	return r.replica
+}
+
+// recentWriter - пользователь из контекста менял задачи в последние readYourWrites
+func (r *taskRepository) recentWriter(ctx context.Context) bool {
+	if r.writers == nil {
+		return false
+	}
+	actor, _ := actorFrom(ctx)
+	return actor.Type == models.ActorUser && r.writers.recent(actor.ID, time.Now())
+}
+
+// readConn - как conn, но вне транзакции читает через readDB
+func (r *taskRepository) readConn(ctx context.Context) database.DBTX {
+	return database.Conn(ctx, r.readDB(ctx))
+}
+
+// wrote отмечает запись пользователя из контекста после коммита транзакции
+func (r *taskRepository) wrote(ctx context.Context) {
+	actor, _ := actorFrom(ctx)
+	if r.writers == nil || actor.Type != models.ActorUser || actor.ID == "" {
+		return
+	}
+	database.AfterCommit(ctx, func() { r.writers.mark(actor.ID, time.Now()) })
+}
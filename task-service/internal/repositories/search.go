@@ -0,0 +1,291 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"strings"
+	"unicode"
+
+	"task-service/database"
+)
+
+// Режимы поиска (SearchFilter.Mode)
+const (
+	SearchFulltext = "fulltext" // по словоформам, синтаксис веб-поиска
+	SearchFuzzy    = "fuzzy"    // по триграммам (pg_trgm): находит слова с опечатками
+	SearchPrefix   = "prefix"   // по началу слов - поиск по мере ввода и подсказки
+)
+
+// SearchFilter - параметры поиска задач
+type SearchFilter struct {
+	// Query - в fulltext в синтаксисе веб-поиска: "точная фраза", or, -исключить;
+	// в fuzzy и prefix - просто слова
+	Query     string
+	Mode      string // SearchFulltext (по умолчанию), SearchFuzzy, SearchPrefix
+	Language  string // только для fulltext: russian, english, simple; пусто - русский и английский сразу
+	UserID    string // только задачи, видимые пользователю
+	ProjectID int    // только задачи проекта
+	// Filter - условия List по полям задачи (статусы, теги, даты ...); его UserID, Trashed,
+	// сортировка и пагинация в поиске не используются
+	Filter    *TaskFilter
+	Page      int
+	Limit     int
+	PageToken string // курсор из TaskPage.NextPageToken вместо Page
+	SkipTotal bool
+	// Facets - какие фасеты посчитать (FacetStatus, FacetTag, FacetCreated)
+	Facets        []string
+	CreatedBucket string // шаг FacetCreated: day, week, month (по умолчанию)
+}
+
+// SearchHit - релевантность найденной задачи и фрагмент текста с подсветкой
+type SearchHit struct {
+	Rank    float64 `json:"rank"`
+	Snippet string  `json:"snippet"`
+}
+
+// searchVectors - конфигурации полнотекстового поиска и колонки с их tsvector
+// (migrations/016_add_search_languages.sql)
+var searchVectors = map[string]string{
+	"russian": "search_vector",
+	"english": "search_vector_en",
+	"simple":  "search_vector_simple",
+}
+
+// defaultSearchLanguages - языки поиска без явного Language
+var defaultSearchLanguages = []string{"russian", "english"}
+
+// Совпадения ts_headline обрамляются управляющими символами, а не сразу <mark>: текст задачи
+// сначала экранируется (escapeSnippet), и только потом маркеры становятся тегами
+const (
+	snippetStart = "\x02"
+	snippetStop  = "\x03"
+)
+
+// headlineOptions - фрагменты ts_headline с маркерами snippetStart/snippetStop
+const headlineOptions = `StartSel=` + snippetStart + `, StopSel=` + snippetStop + `, MaxWords=30, MinWords=10, MaxFragments=2, FragmentDelimiter=" … "`
+
+// snippetMarks - маркеры совпадений в уже экранированном фрагменте
+var snippetMarks = strings.NewReplacer(snippetStart, "<mark>", snippetStop, "</mark>")
+
+// escapeSnippet делает фрагмент безопасным HTML: весь текст задачи экранирован,
+// теги - только <mark></mark> вокруг совпадений
+func escapeSnippet(snippet string) string {
+	return snippetMarks.Replace(html.EscapeString(snippet))
+}
+
+// fuzzyThreshold - минимальное word_similarity в режиме fuzzy. У pg_trgm по умолчанию 0.6,
+// а у слова с одной опечаткой ("молако" - "молоко") сходство около 0.57
+const fuzzyThreshold = "0.4"
+
+// fuzzySnippetLength - в fuzzy совпадения не подсвечиваются, фрагмент - начало текста
+const fuzzySnippetLength = 200
+
+// prefixQuery превращает ввод в tsquery, где каждое слово - префикс: "мол ку" -> "мол:* & ку:*".
+// Остаются только буквы и цифры, так что синтаксис tsquery из ввода не попадает в запрос
+func prefixQuery(input string) string {
+	words := strings.FieldsFunc(input, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	return strings.Join(words, " & ")
+}
+
+// searchExprs - условие, ранг и фрагмент для полнотекстового поиска по языкам langs; q - плейсхолдер запроса
+func searchExprs(langs []string, q string) (match, rank, headline string) {
+	var matches, ranks []string
+	for _, lang := range langs {
+		tsQuery := "websearch_to_tsquery('" + lang + "', " + q + ")"
+		matches = append(matches, searchVectors[lang]+" @@ "+tsQuery)
+		ranks = append(ranks, "ts_rank("+searchVectors[lang]+", "+tsQuery+")")
+	}
+	match = "(" + strings.Join(matches, " OR ") + ")"
+
+	headlineFor := func(lang string) string {
+		return "ts_headline('" + lang + "', text, websearch_to_tsquery('" + lang + "', " + q + "), '" + headlineOptions + "')"
+	}
+	if len(langs) == 1 {
+		return match, ranks[0], headlineFor(langs[0])
+	}
+	// фрагмент - по языку, в котором задача нашлась лучше
+	rank = "GREATEST(" + strings.Join(ranks, ", ") + ")"
+	headline = "CASE WHEN " + ranks[0] + " >= " + ranks[1] + " THEN " + headlineFor(langs[0]) + " ELSE " + headlineFor(langs[1]) + " END"
+	return match, rank, headline
+}
+
+// fuzzyExprs - условие, ранг и фрагмент для поиска по триграммам (migrations/017_add_trigram_search.sql)
+func fuzzyExprs(q string) (match, rank, headline string) {
+	return q + " <% text", "word_similarity(" + q + ", text)", fmt.Sprintf("left(text, %d)", fuzzySnippetLength)
+}
+
+// prefixExprs - условие, ранг и фрагмент для поиска по началу слов; q - плейсхолдер prefixQuery.
+// Конфигурация simple: незаконченное слово не должно проходить через стемминг
+func prefixExprs(q string) (match, rank, headline string) {
+	tsQuery := "to_tsquery('simple', " + q + ")"
+	return "search_vector_simple @@ " + tsQuery,
+		"ts_rank(search_vector_simple, " + tsQuery + ")",
+		"ts_headline('simple', text, " + tsQuery + ", '" + headlineOptions + "')"
+}
+
+// ValidSearchOptions проверяет режим и язык поиска; ошибка - ErrInvalidFilter
+func ValidSearchOptions(mode, language string) error {
+	switch mode {
+	case "", SearchFulltext, SearchFuzzy, SearchPrefix:
+	default:
+		return fmt.Errorf("%w: unsupported search mode %q (fulltext, fuzzy, prefix)", ErrInvalidFilter, mode)
+	}
+	if _, ok := searchVectors[language]; language != "" && !ok {
+		return fmt.Errorf("%w: unsupported search language %q (russian, english, simple)", ErrInvalidFilter, language)
+	}
+	return nil
+}
+
+func (r *taskRepository) Search(ctx context.Context, filter SearchFilter) (*TaskPage, error) {
+	log.Printf("[Search] query=%s, mode=%s, lang=%s, userID=%s, projectID=%d, page=%d, limit=%d",
+		filter.Query, filter.Mode, filter.Language, filter.UserID, filter.ProjectID, filter.Page, filter.Limit)
+
+	if err := ValidSearchOptions(filter.Mode, filter.Language); err != nil {
+		return nil, err
+	}
+	langs := defaultSearchLanguages
+	if filter.Language != "" {
+		langs = []string{filter.Language}
+	}
+
+	b := &queryBuilder{}
+	var match, rankExpr, headline, cursorSort string
+	switch filter.Mode {
+	case "", SearchFulltext:
+		match, rankExpr, headline = searchExprs(langs, b.arg(filter.Query))
+		// курсор действителен только для того же режима и языков: у них свой rank
+		cursorSort = "rank:" + strings.Join(langs, ",")
+	case SearchFuzzy:
+		match, rankExpr, headline = fuzzyExprs(b.arg(filter.Query))
+		cursorSort = "rank:" + SearchFuzzy
+	case SearchPrefix:
+		tsQuery := prefixQuery(filter.Query)
+		if tsQuery == "" {
+			return nil, fmt.Errorf("%w: prefix query has no words", ErrInvalidFilter)
+		}
+		match, rankExpr, headline = prefixExprs(b.arg(tsQuery))
+		cursorSort = "rank:" + SearchPrefix
+	}
+	b.where(match)
+	b.where("deleted_at IS NULL")
+
+	// только задачи, которые пользователь видит: свои и те, где он участник
+	if filter.UserID != "" {
+		b.where(visibleCond(b.arg(filter.UserID)))
+	}
+	if filter.ProjectID > 0 {
+		b.where("project_id = ?", filter.ProjectID)
+	}
+	if filter.Filter != nil {
+		filter.Filter.conditions(b)
+	}
+
+	countQuery := `SELECT COUNT(*) FROM "Tasks" WHERE ` + b.sql()
+	countArgs := b.args
+
+	// фасеты - по всем найденным задачам, без курсора и пагинации
+	facetQueries := make(map[string]string)
+	var facetNames []string
+	for _, name := range filter.Facets {
+		if _, ok := facetQueries[name]; ok {
+			continue
+		}
+		query, err := facetQuery(name, b.sql(), filter.CreatedBucket)
+		if err != nil {
+			return nil, err
+		}
+		facetQueries[name] = query
+		facetNames = append(facetNames, name)
+	}
+
+	// keyset по (rank, id): для одного запроса rank задачи не меняется между страницами
+	if filter.PageToken != "" {
+		after, err := decodeCursor(filter.PageToken, cursorSort)
+		if err != nil {
+			return nil, err
+		}
+		b.where("("+rankExpr+", id) < (?, ?)", after.Rank, after.ID)
+	}
+
+	// сначала страница по релевантности, ts_headline - только для её задач
+	hits := `SELECT id AS hit_id, ` + rankExpr + ` AS hit_rank FROM "Tasks" WHERE ` + b.sql() + `
+	         ORDER BY hit_rank DESC, hit_id DESC`
+	if filter.Limit > 0 {
+		hits += " LIMIT " + b.arg(filter.Limit+1)
+		if filter.Page > 1 && filter.PageToken == "" {
+			hits += " OFFSET " + b.arg((filter.Page-1)*filter.Limit)
+		}
+	}
+	sqlQuery := `SELECT ` + taskColumns + `, hits.hit_rank, ` + headline + `
+	             FROM (` + hits + `) hits JOIN "Tasks" ON "Tasks".id = hits.hit_id
+	             ORDER BY hits.hit_rank DESC, hits.hit_id DESC`
+
+	// выдача, total и фасеты читаются из одного пула (с реплики, если она есть)
+	db := r.readDB(ctx)
+	result := &TaskPage{Total: -1}
+	run := func(ctx context.Context) error {
+		conn := database.Conn(ctx, db)
+		rows, err := conn.QueryContext(ctx, sqlQuery, b.args...)
+		if err != nil {
+			return fmt.Errorf("search query error: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var hit SearchHit
+			t, err := scanTask(rows, &hit.Rank, &hit.Snippet)
+			if err != nil {
+				return fmt.Errorf("scan error: %w", err)
+			}
+			hit.Snippet = escapeSnippet(hit.Snippet)
+			result.Tasks = append(result.Tasks, t)
+			result.Hits = append(result.Hits, hit)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("search query error: %w", err)
+		}
+
+		if !filter.SkipTotal {
+			err = conn.QueryRowContext(ctx, countQuery, countArgs...).Scan(&result.Total)
+			if err != nil {
+				return fmt.Errorf("count query error: %w", err)
+			}
+		}
+
+		result.Facets, err = r.facets(ctx, conn, facetQueries, facetNames, countArgs)
+		return err
+	}
+
+	var err error
+	if filter.Mode == SearchFuzzy {
+		// порог <% задаётся настройкой; SET LOCAL действует только до конца транзакции
+		err = database.WithinTx(ctx, db, func(ctx context.Context) error {
+			_, err := database.Conn(ctx, db).ExecContext(ctx, `SELECT set_config('pg_trgm.word_similarity_threshold', $1, true)`, fuzzyThreshold)
+			if err != nil {
+				return fmt.Errorf("set similarity threshold: %w", err)
+			}
+			return run(ctx)
+		})
+	} else {
+		err = run(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Limit > 0 && len(result.Tasks) > filter.Limit {
+		result.Tasks = result.Tasks[:filter.Limit]
+		result.Hits = result.Hits[:filter.Limit]
+		last := pageCursor{Sort: cursorSort, Rank: result.Hits[filter.Limit-1].Rank, ID: result.Tasks[filter.Limit-1].ID}
+		result.NextPageToken = last.encode()
+	}
+
+	return result, nil
+}
@@ -3,11 +3,13 @@ package repositories
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 	// "log"
 
+	"task-service/database"
 	"task-service/internal/cache"
 	"task-service/internal/models"
 	// "task-service/internal/services"
@@ -49,38 +51,40 @@ func (r *TaskCacheRepository) tasksByStatusKey(status string) string {
 
 // Вспомогательные методы для работы с кэшем
 
-func (r *TaskCacheRepository) List(ctx context.Context, filter TaskFilter) ([]models.Task, int, error) {
-    // Создаём ключ для кэша на основе параметров фильтра
-    cacheKey := fmt.Sprintf("tasks:list:%s:%s:%d:%d", 
-        filter.UserID, filter.Status, filter.Page, filter.Limit)
-    
-    var result struct {
-        Tasks []models.Task `json:"tasks"`
-        Total int           `json:"total"`
+func (r *TaskCacheRepository) List(ctx context.Context, filter TaskFilter) (*TaskPage, error) {
+    // после своей записи пользователь читает мимо кэша (read-your-writes, см. readDB)
+    if r.baseRepo.recentWriter(ctx) {
+        return r.baseRepo.List(ctx, filter)
     }
+
+    // Создаём ключ для кэша на основе всех параметров фильтра
+    filterKey, err := json.Marshal(filter)
+    if err != nil {
+        return nil, err
+    }
+    cacheKey := "tasks:list:" + string(filterKey)
     
     // Пробуем получить из кэша
-    if found, _ := r.getFromCache(ctx, cacheKey, &result); found {
-        return result.Tasks, result.Total, nil
+    var page TaskPage
+    if found, _ := r.getFromCache(ctx, cacheKey, &page); found {
+        return &page, nil
     }
     
     // Если нет в кэше, идём в базовый репозиторий
-    tasks, total, err := r.baseRepo.List(ctx, filter)
+    result, err := r.baseRepo.List(ctx, filter)
     if err != nil {
-        return nil, 0, err
+        return nil, err
     }
     
     // Сохраняем в кэш (используем существующий метод saveToCache)
-    r.saveToCache(ctx, cacheKey, struct {
-        Tasks []models.Task `json:"tasks"`
-        Total int           `json:"total"`
-    }{Tasks: tasks, Total: total}, CacheTTLAllTasks)
+    r.saveToCache(ctx, cacheKey, result, CacheTTLAllTasks)
     
-    return tasks, total, nil
+    return result, nil
 }
 
 func (r *TaskCacheRepository) saveToCache(ctx context.Context, key string, data interface{}, ttl time.Duration) {
-	if data == nil {
+	// прочитанное в транзакции может откатиться - в кэш только закоммиченное
+	if data == nil || database.InTx(ctx) {
 		return
 	}
 	
@@ -131,6 +135,10 @@ func (r *TaskCacheRepository) GetAll(ctx context.Context) ([]models.Task, error)
 }
 
 func (r *TaskCacheRepository) GetByID(ctx context.Context, id int) (*models.Task, error) {
+	// в транзакции - из БД: она видит свои незакоммиченные изменения, кэш - нет
+	if database.InTx(ctx) {
+		return r.baseRepo.GetByID(ctx, id)
+	}
 	cacheKey := r.taskByIDKey(id)
 	var task models.Task
 	
@@ -151,6 +159,11 @@ func (r *TaskCacheRepository) GetByID(ctx context.Context, id int) (*models.Task
 	return taskPtr, nil
 }
 
+// GetForUpdate - всегда из БД: по заблокированной строке сверяют версию
+func (r *TaskCacheRepository) GetForUpdate(ctx context.Context, id int) (*models.Task, error) {
+	return r.baseRepo.GetForUpdate(ctx, id)
+}
+
 func (r *TaskCacheRepository) GetByStatus(ctx context.Context, status string) ([]models.Task, error) {
 	cacheKey := r.tasksByStatusKey(status)
 	var tasks []models.Task
@@ -175,7 +188,10 @@ func (r *TaskCacheRepository) Create(ctx context.Context, task *models.Task) (in
 		return 0, err
 	}
 	
-	go r.invalidateCache(ctx)
+	database.AfterCommit(ctx, func() {
+		r.invalidateCache(context.Background())
+		r.cache.InvalidateByPattern(context.Background(), "tasks:list:")
+	})
 	
 	return id, nil
 }
@@ -190,12 +206,17 @@ func (r *TaskCacheRepository) Update(ctx context.Context, task *models.Task) err
 	
 	// Обновляем
 	err = r.baseRepo.Update(ctx, task)
+	if errors.Is(err, ErrVersionConflict) {
+		// в кэше могла остаться устаревшая version - клиент должен перечитать задачу
+		r.invalidateTaskCache(context.Background(), task.ID, oldTask)
+	}
 	if err != nil {
 		return err
 	}
 	
-	// Инвалидируем кэш асинхронно
-	go r.invalidateTaskCache(context.Background(), task.ID, oldTask)
+	// Инвалидируем синхронно после коммита: следующий GetByID должен вернуть новую version,
+	// а не строку, прочитанную до коммита
+	r.invalidateOnCommit(ctx, task.ID, oldTask)
 	
 	return nil
 }
@@ -219,10 +240,8 @@ func (r *TaskCacheRepository) UpdateStatus(
 		return err
 	}
 	
-	// Инвалидируем
-	go func() {
-		r.invalidateTaskCache(context.Background(), id, oldTask)
-	}()
+	// Инвалидируем синхронно после коммита, как в Update
+	r.invalidateOnCommit(ctx, id, oldTask)
 	
 	return nil
 }
@@ -241,14 +260,42 @@ func (r *TaskCacheRepository) Delete(ctx context.Context, id int) error {
 		return err
 	}
 	
-	// Инвалидация
-	go func() {
-		r.invalidateTaskCache(context.Background(), id, task)
-	}()
+	// Инвалидация: задача ушла в корзину и из списков
+	r.invalidateOnCommit(ctx, id, task)
 	
 	return nil
 }
 
+func (r *TaskCacheRepository) Restore(ctx context.Context, id int) error {
+	if err := r.baseRepo.Restore(ctx, id); err != nil {
+		return err
+	}
+	r.invalidateOnCommit(ctx, id, nil)
+	database.AfterCommit(ctx, func() {
+		r.cache.InvalidateByPattern(context.Background(), "tasks:status:")
+	})
+	return nil
+}
+
+func (r *TaskCacheRepository) GetTrashed(ctx context.Context, id int) (*models.Task, error) {
+	return r.baseRepo.GetTrashed(ctx, id)
+}
+
+func (r *TaskCacheRepository) GetExpiredTrash(ctx context.Context, deletedBefore time.Time, limit int) ([]models.Task, error) {
+	return r.baseRepo.GetExpiredTrash(ctx, deletedBefore, limit)
+}
+
+func (r *TaskCacheRepository) Purge(ctx context.Context, id int, deletedBefore time.Time) (bool, error) {
+	purged, err := r.baseRepo.Purge(ctx, id, deletedBefore)
+	if err != nil {
+		return false, err
+	}
+	database.AfterCommit(ctx, func() {
+		r.cache.InvalidateByPattern(context.Background(), "tasks:list:")
+	})
+	return purged, nil
+}
+
 // Вспомогательные методы для инвалидации
 func (r *TaskCacheRepository) invalidateCache(ctx context.Context) {
 
@@ -269,6 +316,15 @@ func (r *TaskCacheRepository) invalidateTaskCache(ctx context.Context, id int, o
 	}
 }
 
+// invalidateOnCommit сбрасывает задачу и закэшированные списки сразу после коммита
+// транзакции из контекста (вне транзакции - сразу): иначе список ещё 30 минут без изменения
+func (r *TaskCacheRepository) invalidateOnCommit(ctx context.Context, id int, oldTask *models.Task) {
+	database.AfterCommit(ctx, func() {
+		r.invalidateTaskCache(context.Background(), id, oldTask)
+		r.cache.InvalidateByPattern(context.Background(), "tasks:list:")
+	})
+}
+
 // Методы для управления кэшем
 func (r *TaskCacheRepository) WarmUpCache(ctx context.Context) error {
 	// Загружаем все задачи
@@ -326,9 +382,9 @@ func (r *TaskCacheRepository) incMiss() {
 }
 
 //полнотекстовый поиск
-func (r *TaskCacheRepository) Search(ctx context.Context, query, userID string, page, limit int) ([]models.Task, int, error) {
+func (r *TaskCacheRepository) Search(ctx context.Context, filter SearchFilter) (*TaskPage, error) {
     // Поиск не кэшируем
-    return r.baseRepo.Search(ctx, query, userID, page, limit)
+    return r.baseRepo.Search(ctx, filter)
 }
 // var _ services.TaskRepository = (*TaskCacheRepository)(nil)
 
@@ -341,7 +397,7 @@ func (r *TaskCacheRepository) IncrementAttempts(ctx context.Context, id int) err
     }
     
     // Инвалидируем кэш задачи
-    go r.invalidateTaskCache(context.Background(), id, nil)
+    r.invalidateOnCommit(ctx, id, nil)
     
     return nil
 }
@@ -371,4 +427,88 @@ func (r *TaskCacheRepository) GetActiveTasksCount(ctx context.Context, userID st
     
     return count, nil
 	//TODO: настроить инвалидацию кэша в части активных задач пользователя. Задача после state machine по БД получает статус READY_FOR_CLOSURE, а при запросе с кэша получаю ответ, что статус задачи NEW
-}
\ No newline at end of file
+}
+
+func (r *TaskCacheRepository) GetActiveTasksCountInProject(ctx context.Context, userID string, projectID int) (int, error) {
+	return r.baseRepo.GetActiveTasksCountInProject(ctx, userID, projectID)
+}
+
+// SetFailureReason сохраняет причину отказа и инвалидирует кэш задачи
+func (r *TaskCacheRepository) SetFailureReason(ctx context.Context, id int, reason *models.ValidationReason) error {
+	if err := r.baseRepo.SetFailureReason(ctx, id, reason); err != nil {
+		return err
+	}
+	r.invalidateOnCommit(ctx, id, nil)
+	return nil
+}
+
+func (r *TaskCacheRepository) CountCreatedSince(ctx context.Context, userID string, since time.Time) (int, error) {
+	return r.baseRepo.CountCreatedSince(ctx, userID, since)
+}
+
+func (r *TaskCacheRepository) CountDuplicates(ctx context.Context, userID, text string, excludeID int) (int, error) {
+	return r.baseRepo.CountDuplicates(ctx, userID, text, excludeID)
+}
+
+// GetHistory - история не кэшируется
+func (r *TaskCacheRepository) GetHistory(ctx context.Context, taskID int) ([]models.TaskTransition, error) {
+	return r.baseRepo.GetHistory(ctx, taskID)
+}
+
+func (r *TaskCacheRepository) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return r.baseRepo.WithinTx(ctx, fn)
+}
+
+func (r *TaskCacheRepository) GetOverdue(ctx context.Context, now time.Time, excludeStatuses []string) ([]models.Task, error) {
+	return r.baseRepo.GetOverdue(ctx, now, excludeStatuses)
+}
+
+// MarkOverdue с инвалидацией кэша
+func (r *TaskCacheRepository) MarkOverdue(ctx context.Context, id int, priority models.Priority) (bool, error) {
+	marked, err := r.baseRepo.MarkOverdue(ctx, id, priority)
+	if err != nil {
+		return false, err
+	}
+	r.invalidateOnCommit(ctx, id, nil)
+	return marked, nil
+}
+
+func (r *TaskCacheRepository) CountOpenBlockers(ctx context.Context, taskID int, doneStatuses []string) (int, error) {
+	return r.baseRepo.CountOpenBlockers(ctx, taskID, doneStatuses)
+}
+
+func (r *TaskCacheRepository) AddDependency(ctx context.Context, taskID, blockedByID int) error {
+	return r.baseRepo.AddDependency(ctx, taskID, blockedByID)
+}
+
+func (r *TaskCacheRepository) RemoveDependency(ctx context.Context, taskID, blockedByID int) (bool, error) {
+	return r.baseRepo.RemoveDependency(ctx, taskID, blockedByID)
+}
+
+func (r *TaskCacheRepository) GetSubtree(ctx context.Context, rootID int) ([]models.Task, error) {
+	return r.baseRepo.GetSubtree(ctx, rootID)
+}
+
+// AddMember/RemoveMember меняют видимость задачи: сбрасываем задачу и закэшированные списки
+func (r *TaskCacheRepository) AddMember(ctx context.Context, taskID int, userID, role string) (bool, error) {
+	added, err := r.baseRepo.AddMember(ctx, taskID, userID, role)
+	if err != nil {
+		return false, err
+	}
+	r.invalidateOnCommit(ctx, taskID, nil)
+	return added, nil
+}
+
+func (r *TaskCacheRepository) RemoveMember(ctx context.Context, taskID int, userID, role string) (bool, error) {
+	removed, err := r.baseRepo.RemoveMember(ctx, taskID, userID, role)
+	if err != nil {
+		return false, err
+	}
+	r.invalidateOnCommit(ctx, taskID, nil)
+	return removed, nil
+}
+
+
+func (r *TaskCacheRepository) GetBlockers(ctx context.Context, taskIDs []int) (map[int][]models.Task, error) {
+	return r.baseRepo.GetBlockers(ctx, taskIDs)
+}
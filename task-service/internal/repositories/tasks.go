@@ -2,16 +2,43 @@ package repositories
 
 import (
 	"database/sql"
+    "encoding/json"
+    "errors"
+    "strings"
     "time"
+	"task-service/database"
+	"task-service/internal/blobstore"
 	"task-service/internal/models"
     "context"
-    "strconv"
     "fmt"
     "log"
+
+    "github.com/lib/pq"
+)
+
+var (
+    // ErrVersionConflict - задачу изменили после того, как клиент её прочитал
+    ErrVersionConflict = errors.New("task version conflict")
+    // ErrInvalidFilter - недопустимый параметр фильтра или сортировки
+    ErrInvalidFilter = errors.New("invalid filter")
+    // ErrDependencyCycle - зависимость замкнула бы цикл блокировок
+    ErrDependencyCycle = errors.New("dependency cycle")
+    // ErrParentDeleted - подзадачу нельзя восстановить, пока родитель в корзине
+    ErrParentDeleted = errors.New("parent task is in trash")
 )
 
+// dependencyLockKey - advisory lock, под которым меняются зависимости (проверка цикла без гонок)
+const dependencyLockKey = 0x74646570 // "tdep"
+
 type taskRepository struct {
-	db *sql.DB
+	db    *sql.DB
+	blobs blobstore.BlobStore // содержимое вложений, удаляется вместе с задачей
+
+	replica *sql.DB        // для List, Search и GetHistory; nil - всё на primary
+	writers *recentWriters // read-your-writes: кто недавно писал, читает с primary
+
+	// activeStatuses - нетерминальные состояния workflow: задачи в них считаются активными
+	activeStatuses []string
 }
 
 type TaskFilter struct {
@@ -19,14 +46,59 @@ type TaskFilter struct {
 	Status string
 	Page   int
 	Limit  int
+
+	Statuses   []string // любой из (вместе со Status)
+	Priorities []models.Priority // любой из
+	DueAfter   *time.Time
+	DueBefore  *time.Time
+	// диапазоны дат: After включительно, Before - нет
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	EndedAfter    *time.Time
+	EndedBefore   *time.Time
+	TextPrefix    string // текст начинается с (без учёта регистра)
+	MinAttempts   *int
+	MaxAttempts   *int
+	Overdue    bool     // только просроченные (отмеченные state machine)
+	Tags       []string // все перечисленные теги
+	AssigneeID string   // задачи, где пользователь - исполнитель
+	WatcherID  string   // задачи, где пользователь - наблюдатель
+	SortBy     string   // ключ sortColumns, по умолчанию created_at
+	SortAsc    bool
+	ProjectID  int // задачи проекта
+	Trashed    bool // задачи в корзине вместо обычных
+
+	// PageToken - курсор из TaskPage.NextPageToken: keyset-пагинация вместо Page.
+	// Работает для сортировки по created_at и deleted_at
+	PageToken string
+	SkipTotal bool // не считать Total (COUNT(*) по всему фильтру)
 }
 
-func NewTaskRepository(db *sql.DB) *taskRepository {
-	return &taskRepository{db: db}
+// sortColumns - допустимые значения TaskFilter.SortBy: колонки с индексом
+var sortColumns = map[string]string{
+	"":           "created_at",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"ended_at":   "ended_at",
+	"priority":   "priority",
+	"due_at":     "due_at",
+	"status":     "status",
+	"attempts":   "attempts",
+	"id":         "id",
+	"deleted_at": "deleted_at", // для корзины
+	// по первому по алфавиту тегу
+	"tag": `(SELECT MIN(tg.name) FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id WHERE tt.task_id = "Tasks".id)`,
+}
+
+// NewTaskRepository - activeStatuses берутся из описания workflow (Definition.NonTerminalStates)
+func NewTaskRepository(db *sql.DB, blobs blobstore.BlobStore, activeStatuses []string) *taskRepository {
+	return &taskRepository{db: db, blobs: blobs, activeStatuses: activeStatuses}
 }
 
 func (r *taskRepository) GetAll(ctx context.Context) ([]models.Task, error) {
-	rows, err := r.db.Query(`SELECT id, text, status, created_at, started_at, ended_at, user_id FROM "Tasks" ORDER BY id`)
+	rows, err := r.db.Query(`SELECT id, text, status, created_at, started_at, ended_at, user_id FROM "Tasks" WHERE deleted_at IS NULL ORDER BY id`)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +125,10 @@ func (r *taskRepository) GetAll(ctx context.Context) ([]models.Task, error) {
 }
 
 func (r *taskRepository) GetByStatus(ctx context.Context, status string) ([]models.Task, error) {
-    query := `SELECT id, user_id, text, status, created_at, started_at, ended_at, updated_at, attempts 
-              FROM "Tasks" WHERE status = $1 ORDER BY id`
+    query := `SELECT ` + taskColumns + `
+              FROM "Tasks" WHERE status = $1 AND deleted_at IS NULL ORDER BY id`
 
-    rows, err := r.db.QueryContext(ctx, query, status)
+    rows, err := r.conn(ctx).QueryContext(ctx, query, status)
     if err != nil {
         return nil, err
     }
@@ -64,44 +136,13 @@ func (r *taskRepository) GetByStatus(ctx context.Context, status string) ([]mode
 
     var tasks []models.Task
     for rows.Next() {
-    var t models.Task
-    var startedAt sql.NullTime
-    var endedAt   sql.NullTime
-    var updatedAt time.Time  
-    var attempts int          
-
-    if err := rows.Scan(
-        &t.ID,
-        &t.UserID,
-        &t.Text,
-        &t.Status,
-        &t.CreatedAt,
-        &startedAt,
-        &endedAt,
-        &updatedAt,
-        &attempts,
-    ); err != nil {
-        return nil, err
-    }
-
-    t.UpdatedAt = updatedAt
-    t.Attempts = attempts
-
-    if startedAt.Valid {
-        t.StartedAt = &startedAt.Time
-    } else {
-        t.StartedAt = nil
-    }
-
-    if endedAt.Valid {
-        t.EndedAt = &endedAt.Time
-    } else {
-        t.EndedAt = nil
+        t, err := scanTask(rows)
+        if err != nil {
+            return nil, err
+        }
+        tasks = append(tasks, t)
     }
 
-    tasks = append(tasks, t)
-}
-
     return tasks, rows.Err()
 }
 
@@ -114,52 +155,83 @@ func (r *taskRepository) UpdateStatus(
 	query := `UPDATE "Tasks"
 	          SET status = $1,
                   updated_at = NOW(),
+                  version = version + 1,
 	              started_at = $2,
 	              ended_at = $3
 	          WHERE id = $4`
 
-	_, err := r.db.ExecContext(ctx, query, status, startedAt, endedAt, id)
-	return err
+	// смена статуса и запись в историю - в одной транзакции
+	return r.WithinTx(ctx, func(ctx context.Context) error {
+		from, err := r.lockStatus(ctx, id)
+		if err != nil {
+			return err
+		}
+		if _, err := r.conn(ctx).ExecContext(ctx, query, status, startedAt, endedAt, id); err != nil {
+			return err
+		}
+		r.wrote(ctx)
+		return r.recordTransition(ctx, id, from, status)
+	})
 }
 
 func (r *taskRepository) GetByID(ctx context.Context, id int) (*models.Task, error) {
-    query := `SELECT id, text, status, created_at, started_at, ended_at, user_id
+    query := `SELECT ` + taskColumns + `
               FROM "Tasks"
-              WHERE id = $1`
+              WHERE id = $1 AND deleted_at IS NULL`
 
-    row := r.db.QueryRowContext(ctx, query, id)
-
-    var t models.Task
-    var startedAt sql.NullTime
-    var endedAt   sql.NullTime
-
-    if err := row.Scan(
-        &t.ID,
-        &t.Text,
-        &t.Status,
-        &t.CreatedAt,
-        &startedAt,
-        &endedAt,
-        &t.UserID,
-    ); err != nil {
+    t, err := scanTask(r.conn(ctx).QueryRowContext(ctx, query, id))
+    if err != nil {
         return nil, err
     }
+    return &t, nil
+}
 
-    if startedAt.Valid {
-        t.StartedAt = &startedAt.Time
-    }
-    if endedAt.Valid {
-        t.EndedAt = &endedAt.Time
+// GetForUpdate читает задачу и блокирует её строку до конца транзакции из контекста:
+// версия и статус не изменятся, пока изменение по ним не записано
+func (r *taskRepository) GetForUpdate(ctx context.Context, id int) (*models.Task, error) {
+    if !database.InTx(ctx) {
+        return nil, database.ErrNoTx
     }
+    query := `SELECT ` + taskColumns + `
+              FROM "Tasks"
+              WHERE id = $1 AND deleted_at IS NULL
+              FOR UPDATE`
 
+    t, err := scanTask(r.conn(ctx).QueryRowContext(ctx, query, id))
+    if err != nil {
+        return nil, err
+    }
     return &t, nil
 }
 
 func (r *taskRepository) Create(ctx context.Context, task *models.Task) (int, error) {
-		query := `INSERT INTO "Tasks" (text, status, user_id, created_at, updated_at, attempts) VALUES ($1, $2, $3, NOW(), NOW(), 0) RETURNING id`
+		query := `INSERT INTO "Tasks" (text, status, user_id, created_at, updated_at, attempts, priority, due_at, parent_id, project_id) VALUES ($1, $2, $3, NOW(), NOW(), 0, $4, $5, $6, $7) RETURNING id`
         var id int
 
-		err := r.db.QueryRowContext(ctx, query, task.Text, task.Status, task.UserID).Scan(&id)
+        if !task.Priority.Valid() {
+            task.Priority = models.PriorityMedium
+        }
+
+		err := r.WithinTx(ctx, func(ctx context.Context) error {
+            if err := r.conn(ctx).QueryRowContext(ctx, query, task.Text, task.Status, task.UserID, task.Priority, task.DueAt, task.ParentID, task.ProjectID).Scan(&id); err != nil {
+                return err
+            }
+            if err := r.setTags(ctx, id, task.Tags); err != nil {
+                return err
+            }
+            for _, userID := range task.Assignees {
+                if _, err := r.AddMember(ctx, id, userID, models.MemberAssignee); err != nil {
+                    return err
+                }
+            }
+            for _, userID := range task.Watchers {
+                if _, err := r.AddMember(ctx, id, userID, models.MemberWatcher); err != nil {
+                    return err
+                }
+            }
+            r.wrote(ctx)
+            return r.recordTransition(ctx, id, "", task.Status)
+        })
         if err != nil {
             return 0, err
         }
@@ -167,207 +239,763 @@ func (r *taskRepository) Create(ctx context.Context, task *models.Task) (int, er
 		task.ID = id
         task.CreatedAt = time.Now()
         task.UpdatedAt = time.Now()
+        task.Version = 1
 
 		return id, nil
 }
 
+// Update сохраняет задачу, если её version в БД совпадает с task.Version
+// (иначе ErrVersionConflict), и увеличивает version
 func (r *taskRepository) Update(ctx context.Context, task *models.Task) error {
+    // при переносе срока просрочку нужно отметить заново
     query := `UPDATE "Tasks"
               SET text = $1,
               status = $2,
-              updated_at = NOW()
-              WHERE id = $3`
+              updated_at = NOW(),
+              version = version + 1,
+              priority = $5,
+              overdue_at = CASE WHEN due_at IS DISTINCT FROM $6 THEN NULL ELSE overdue_at END,
+              due_at = $6
+              WHERE id = $3 AND version = $4`
+
+    if !task.Priority.Valid() {
+        task.Priority = models.PriorityMedium
+    }
 
-    _, err := r.db.ExecContext(ctx, query, task.Text, task.Status, task.ID)
-    return err
+    return r.WithinTx(ctx, func(ctx context.Context) error {
+        from, err := r.lockStatus(ctx, task.ID)
+        if err != nil {
+            return err
+        }
+        res, err := r.conn(ctx).ExecContext(ctx, query, task.Text, task.Status, task.ID, task.Version, task.Priority, task.DueAt)
+        if err != nil {
+            return err
+        }
+        if n, err := res.RowsAffected(); err != nil {
+            return err
+        } else if n == 0 {
+            return ErrVersionConflict
+        }
+        task.Version++
+        if err := r.setTags(ctx, task.ID, task.Tags); err != nil {
+            return err
+        }
+        r.wrote(ctx)
+        return r.recordTransition(ctx, task.ID, from, task.Status)
+    })
 }
 
+// Delete переносит задачу вместе с подзадачами в корзину (deleted_at).
+// Все они получают одно время удаления - по нему Restore вернёт ровно их
 func (r *taskRepository) Delete(ctx context.Context, id int) error {
-    _, err := r.db.ExecContext(ctx, `DELETE FROM "Tasks" WHERE id = $1`, id)
-    return err
+    res, err := r.conn(ctx).ExecContext(ctx,
+        `WITH RECURSIVE tree AS (
+             SELECT id FROM "Tasks" WHERE id = $1 AND deleted_at IS NULL
+             UNION
+             SELECT t.id FROM "Tasks" t JOIN tree ON t.parent_id = tree.id WHERE t.deleted_at IS NULL
+         )
+         UPDATE "Tasks" SET deleted_at = NOW(), updated_at = NOW(), version = version + 1
+         WHERE id IN (SELECT id FROM tree)`, id)
+    if err != nil {
+        return err
+    }
+    if n, err := res.RowsAffected(); err != nil || n == 0 {
+        if err == nil {
+            err = sql.ErrNoRows
+        }
+        return err
+    }
+    r.wrote(ctx)
+    return nil
 }
 
-func (r *taskRepository) List(ctx context.Context, filter TaskFilter) ([]models.Task, int, error) {
-	// Базовый запрос
-	query := `SELECT id, text, status, created_at, started_at, ended_at, user_id 
-	          FROM "Tasks" WHERE 1=1`
-	countQuery := `SELECT COUNT(*) FROM "Tasks" WHERE 1=1`
-	
-	var args []interface{}
-	var countArgs []interface{}
-	argIndex := 1
-	
-	// фильтры
-	if filter.UserID != "" {
-		query += " AND user_id = $" + string('0'+byte(argIndex))
-		countQuery += " AND user_id = $" + string('0'+byte(argIndex))
-		args = append(args, filter.UserID)
-		countArgs = append(countArgs, filter.UserID)
-		argIndex++
+// Restore возвращает задачу из корзины вместе с подзадачами, удалёнными вместе с ней
+// (удалённые раньше отдельно остаются в корзине)
+func (r *taskRepository) Restore(ctx context.Context, id int) error {
+    return r.WithinTx(ctx, func(ctx context.Context) error {
+        conn := r.conn(ctx)
+        var deletedAt time.Time
+        var parentID sql.NullInt64
+        err := conn.QueryRowContext(ctx,
+            `SELECT deleted_at, parent_id FROM "Tasks" WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE`,
+            id).Scan(&deletedAt, &parentID)
+        if err != nil {
+            return err
+        }
+        if parentID.Valid {
+            var parentDeleted bool
+            err := conn.QueryRowContext(ctx,
+                `SELECT deleted_at IS NOT NULL FROM "Tasks" WHERE id = $1`, parentID.Int64).Scan(&parentDeleted)
+            if err != nil {
+                return err
+            }
+            if parentDeleted {
+                return ErrParentDeleted
+            }
+        }
+
+        _, err = conn.ExecContext(ctx,
+            `WITH RECURSIVE tree AS (
+                 SELECT id FROM "Tasks" WHERE id = $1
+                 UNION
+                 SELECT t.id FROM "Tasks" t JOIN tree ON t.parent_id = tree.id WHERE t.deleted_at = $2
+             )
+             UPDATE "Tasks" SET deleted_at = NULL, updated_at = NOW(), version = version + 1
+             WHERE id IN (SELECT id FROM tree)`, id, deletedAt)
+        if err != nil {
+            return err
+        }
+        r.wrote(ctx)
+        return nil
+    })
+}
+
+// GetTrashed - задача из корзины
+func (r *taskRepository) GetTrashed(ctx context.Context, id int) (*models.Task, error) {
+    t, err := scanTask(r.conn(ctx).QueryRowContext(ctx,
+        `SELECT `+taskColumns+` FROM "Tasks" WHERE id = $1 AND deleted_at IS NOT NULL`, id))
+    if err != nil {
+        return nil, err
+    }
+    return &t, nil
+}
+
+// GetExpiredTrash - задачи, лежащие в корзине с момента раньше deletedBefore
+func (r *taskRepository) GetExpiredTrash(ctx context.Context, deletedBefore time.Time, limit int) ([]models.Task, error) {
+    rows, err := r.conn(ctx).QueryContext(ctx,
+        `SELECT `+taskColumns+` FROM "Tasks"
+         WHERE deleted_at < $1
+         ORDER BY deleted_at, id
+         LIMIT $2`, deletedBefore, limit)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    var tasks []models.Task
+    for rows.Next() {
+        t, err := scanTask(rows)
+        if err != nil {
+            return nil, err
+        }
+        tasks = append(tasks, t)
+    }
+    return tasks, rows.Err()
+}
+
+// Purge окончательно удаляет задачу из корзины вместе с подзадачами (каскад по parent_id)
+// и их вложениями; false - задачи уже нет в корзине или она удалена позже deletedBefore.
+// Блобы удаляются только после коммита транзакции
+func (r *taskRepository) Purge(ctx context.Context, id int, deletedBefore time.Time) (bool, error) {
+    var purged bool
+    err := r.WithinTx(ctx, func(ctx context.Context) error {
+        var keys []string
+        err := r.conn(ctx).QueryRowContext(ctx,
+            `WITH RECURSIVE tree AS (
+                 SELECT id FROM "Tasks" WHERE id = $1
+                 UNION
+                 SELECT t.id FROM "Tasks" t JOIN tree ON t.parent_id = tree.id
+             )
+             SELECT ARRAY(SELECT storage_key FROM task_attachments WHERE task_id IN (SELECT id FROM tree))`,
+            id).Scan(pq.Array(&keys))
+        if err != nil {
+            return err
+        }
+
+        res, err := r.conn(ctx).ExecContext(ctx,
+            `DELETE FROM "Tasks" WHERE id = $1 AND deleted_at < $2`, id, deletedBefore)
+        if err != nil {
+            return err
+        }
+        n, err := res.RowsAffected()
+        if err != nil || n == 0 {
+            return err
+        }
+        purged = true
+
+        if len(keys) > 0 && r.blobs != nil {
+            database.AfterCommit(ctx, func() {
+                r.deleteBlobs(keys)
+            })
+        }
+        return nil
+    })
+    return purged, err
+}
+
+// deleteBlobs - ошибка не откатывает удаление задачи, блоб остаётся сиротой
+func (r *taskRepository) deleteBlobs(keys []string) {
+    for _, key := range keys {
+        if err := r.blobs.Delete(context.Background(), key); err != nil {
+            log.Printf("[attachments] failed to delete blob %s: %v", key, err)
+        }
+    }
+}
+
+// conditions добавляет в b условия фильтра по полям задачи: статусы, приоритеты, даты,
+// теги, участники, проект. Владелец, корзина, сортировка и пагинация - забота вызывающего
+func (f *TaskFilter) conditions(b *queryBuilder) {
+	statuses := f.Statuses
+	if f.Status != "" {
+		statuses = append([]string{f.Status}, statuses...)
+	}
+	if len(statuses) > 0 {
+		b.where("status = ANY(?)", pq.Array(statuses))
+	}
+	if len(f.Priorities) > 0 {
+		priorities := make([]int64, len(f.Priorities))
+		for i, p := range f.Priorities {
+			priorities[i] = int64(p)
+		}
+		b.where("priority = ANY(?)", pq.Array(priorities))
+	}
+
+	ranges := []struct {
+		column        string
+		after, before *time.Time
+	}{
+		{"due_at", f.DueAfter, f.DueBefore},
+		{"created_at", f.CreatedAfter, f.CreatedBefore},
+		{"updated_at", f.UpdatedAfter, f.UpdatedBefore},
+		{"ended_at", f.EndedAfter, f.EndedBefore},
+	}
+	for _, rng := range ranges {
+		if rng.after != nil {
+			b.where(rng.column+" >= ?", *rng.after)
+		}
+		if rng.before != nil {
+			b.where(rng.column+" < ?", *rng.before)
+		}
+	}
+
+	if prefix := strings.TrimSpace(f.TextPrefix); prefix != "" {
+		// % и _ из запроса - обычные символы, а не шаблон LIKE
+		b.where(`lower(text) LIKE ? ESCAPE '\'`, likeEscaper.Replace(strings.ToLower(prefix))+"%")
+	}
+	if f.MinAttempts != nil {
+		b.where("attempts >= ?", *f.MinAttempts)
+	}
+	if f.MaxAttempts != nil {
+		b.where("attempts <= ?", *f.MaxAttempts)
+	}
+	if f.Overdue {
+		b.where("overdue_at IS NOT NULL")
 	}
-	
-	if filter.Status != "" {
-		query += " AND status = $" + string('0'+byte(argIndex))
-		countQuery += " AND status = $" + string('0'+byte(argIndex))
-		args = append(args, filter.Status)
-		countArgs = append(countArgs, filter.Status)
-		argIndex++
+	if tags := normalizeTags(f.Tags); len(tags) > 0 {
+		b.where(`id IN (SELECT tt.task_id FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id
+		                WHERE tg.name = ANY(?) GROUP BY tt.task_id HAVING COUNT(*) = ?)`,
+			pq.Array(tags), len(tags))
 	}
-	
+	if f.AssigneeID != "" {
+		b.where(`id IN (SELECT task_id FROM task_members WHERE role = 'assignee' AND user_id = ?)`, f.AssigneeID)
+	}
+	if f.ProjectID > 0 {
+		b.where("project_id = ?", f.ProjectID)
+	}
+	if f.WatcherID != "" {
+		b.where(`id IN (SELECT task_id FROM task_members WHERE role = 'watcher' AND user_id = ?)`, f.WatcherID)
+	}
+}
+
+func (r *taskRepository) List(ctx context.Context, filter TaskFilter) (*TaskPage, error) {
+	b := &queryBuilder{}
+	// корзина отдельно от обычных задач
+	if filter.Trashed {
+		b.where("deleted_at IS NOT NULL")
+	} else {
+		b.where("deleted_at IS NULL")
+	}
+
+	if filter.UserID != "" {
+		b.where("user_id = ?", filter.UserID)
+	}
+	filter.conditions(b)
+
+	// total - по тем же условиям, но без курсора и пагинации
+	countQuery := `SELECT COUNT(*) FROM "Tasks" WHERE ` + b.sql()
+	countArgs := b.args
+
 	// сортировка и пагинация
-	query += " ORDER BY created_at DESC"
-	
+	sortColumn, ok := sortColumns[filter.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported sort field %q", ErrInvalidFilter, filter.SortBy)
+	}
+	direction := " DESC NULLS LAST"
+	cmp := "<"
+	if filter.SortAsc {
+		direction = " ASC NULLS LAST"
+		cmp = ">"
+	}
+	// keyset - по колонке без NULL в выборке, вместе с id она однозначно задаёт порядок
+	keyset := sortColumn == "created_at" || (sortColumn == "deleted_at" && filter.Trashed)
+	sortKey := sortColumn + direction
+
+	// keyset: строки строго после последней задачи прошлой страницы. В отличие от OFFSET
+	// не даёт дублей и пропусков, когда между запросами страниц появляются новые задачи
+	if filter.PageToken != "" {
+		if !keyset {
+			return nil, fmt.Errorf("%w: page_token is not supported for sort by %q", ErrInvalidFilter, filter.SortBy)
+		}
+		after, err := decodeCursor(filter.PageToken, sortKey)
+		if err != nil {
+			return nil, err
+		}
+		b.where("("+sortColumn+", id) "+cmp+" (?, ?)", after.Time, after.ID)
+	}
+
+	query := `SELECT ` + taskColumns + ` FROM "Tasks" WHERE ` + b.sql()
+	query += " ORDER BY " + sortColumn + direction
+	if sortColumn != "id" {
+		query += ", id" + direction
+	}
 	if filter.Limit > 0 {
-		query += " LIMIT $" + string('0'+byte(argIndex))
-		args = append(args, filter.Limit)
-		argIndex++
-		
-		if filter.Page > 1 {
-			offset := (filter.Page - 1) * filter.Limit
-			query += " OFFSET $" + string('0'+byte(argIndex))
-			args = append(args, offset)
-			argIndex++
+		// лишняя строка - признак того, что есть следующая страница
+		query += " LIMIT " + b.arg(filter.Limit+1)
+		if filter.Page > 1 && filter.PageToken == "" {
+			query += " OFFSET " + b.arg((filter.Page-1)*filter.Limit)
 		}
 	}
-	
-	// запрос для получения задач
-	rows, err := r.db.QueryContext(ctx, query, args...)
+
+	// страница и total - с реплики, если она есть
+	conn := r.readConn(ctx)
+	rows, err := conn.QueryContext(ctx, query, b.args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
 	}
 	defer rows.Close()
-	
-	var tasks []models.Task
+
+	page := &TaskPage{Total: -1}
 	for rows.Next() {
-		var t models.Task
-		var startedAt sql.NullTime
-		var endedAt sql.NullTime
-		
-		if err := rows.Scan(
-			&t.ID,
-			&t.Text,
-			&t.Status,
-			&t.CreatedAt,
-			&startedAt,
-			&endedAt,
-			&t.UserID,
-		); err != nil {
-			return nil, 0, err
-		}
-		
-		if startedAt.Valid {
-			t.StartedAt = &startedAt.Time
+		t, err := scanTask(rows)
+		if err != nil {
+			return nil, err
 		}
-		if endedAt.Valid {
-			t.EndedAt = &endedAt.Time
+		page.Tasks = append(page.Tasks, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if filter.Limit > 0 && len(page.Tasks) > filter.Limit {
+		page.Tasks = page.Tasks[:filter.Limit]
+		if keyset {
+			page.NextPageToken = listCursor(&page.Tasks[filter.Limit-1], sortColumn, sortKey).encode()
 		}
-		
-		tasks = append(tasks, t)
 	}
-	
-	// Получаем общее количество
-	var total int
-	err = r.db.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total)
-	if err != nil {
-		return nil, 0, err
+
+	if !filter.SkipTotal {
+		err = conn.QueryRowContext(ctx, countQuery, countArgs...).Scan(&page.Total)
+		if err != nil {
+			return nil, err
+		}
 	}
-	
-	return tasks, total, nil
-}
-
-func (r *taskRepository) Search(ctx context.Context, query, userID string, page, limit int) ([]models.Task, int, error) {
-    // Базовый запрос с полнотекстовым поиском
-    log.Printf("[Search] query=%s, userID=%s, page=%d, limit=%d", query, userID, page, limit)
-    sqlQuery := `
-        SELECT id, text, status, created_at, started_at, ended_at, user_id,
-               ts_rank(search_vector, plainto_tsquery('russian', $1)) as rank
-        FROM "Tasks"
-        WHERE search_vector @@ plainto_tsquery('russian', $1)
-    `
-    countQuery := `
-        SELECT COUNT(*)
-        FROM "Tasks"
-        WHERE search_vector @@ plainto_tsquery('russian', $1)
-    `
-    
-    args := []interface{}{query}
-    countArgs := []interface{}{query}
-    
-    // фильтр по user_id если указан
-    if userID != "" {
-        sqlQuery += " AND user_id = $" + strconv.Itoa(len(args)+1)
-        countQuery += " AND user_id = $" + strconv.Itoa(len(countArgs)+1)
-        args = append(args, userID)
-        countArgs = append(countArgs, userID)
-    }
-    
-    // Сортировка по релевантности
-    sqlQuery += " ORDER BY rank DESC"
-    
-    // Пагинация
-    if limit > 0 {
-        sqlQuery += " LIMIT $" + strconv.Itoa(len(args)+1)
-        args = append(args, limit)
-        
-        if page > 1 {
-            offset := (page - 1) * limit
-            sqlQuery += " OFFSET $" + strconv.Itoa(len(args)+1)
-            args = append(args, offset)
+
+	return page, nil
+}
+
+// likeEscaper экранирует спецсимволы LIKE (ESCAPE '\')
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// IncrementAttempts увеличивает счётчик попыток валидации задачи
+func (r *taskRepository) IncrementAttempts(ctx context.Context, id int) error {
+    query := `UPDATE "Tasks" SET attempts = attempts + 1, updated_at = NOW(), version = version + 1 WHERE id = $1`
+    _, err := r.conn(ctx).ExecContext(ctx, query, id)
+    return err
+}
+
+func (r *taskRepository) GetActiveTasksCount(ctx context.Context, userID string) (int, error) {
+    query := `SELECT COUNT(*) FROM "Tasks" 
+              WHERE user_id = $1 AND deleted_at IS NULL
+              AND status = ANY($2)`
+    var count int
+    err := r.conn(ctx).QueryRowContext(ctx, query, userID, pq.Array(r.activeStatuses)).Scan(&count)
+    return count, err
+}
+
+// GetActiveTasksCountInProject - активные задачи пользователя в проекте
+func (r *taskRepository) GetActiveTasksCountInProject(ctx context.Context, userID string, projectID int) (int, error) {
+    query := `SELECT COUNT(*) FROM "Tasks" 
+              WHERE user_id = $1 AND project_id = $2 AND deleted_at IS NULL
+              AND status = ANY($3)`
+    var count int
+    err := r.conn(ctx).QueryRowContext(ctx, query, userID, projectID, pq.Array(r.activeStatuses)).Scan(&count)
+    return count, err
+}
+
+// SetFailureReason сохраняет причину неудачной валидации (nil - очищает)
+func (r *taskRepository) SetFailureReason(ctx context.Context, id int, reason *models.ValidationReason) error {
+    var data []byte
+    if reason != nil {
+        var err error
+        if data, err = json.Marshal(reason); err != nil {
+            return err
         }
     }
-    
-    // поиск
-    rows, err := r.db.QueryContext(ctx, sqlQuery, args...)
+    _, err := r.conn(ctx).ExecContext(ctx, `UPDATE "Tasks" SET failure_reason = $1, version = version + 1 WHERE id = $2`, data, id)
+    return err
+}
+
+// CountCreatedSince - сколько задач пользователь создал начиная с since
+func (r *taskRepository) CountCreatedSince(ctx context.Context, userID string, since time.Time) (int, error) {
+    var count int
+    err := r.conn(ctx).QueryRowContext(ctx,
+        `SELECT COUNT(*) FROM "Tasks" WHERE user_id = $1 AND created_at >= $2`,
+        userID, since).Scan(&count)
+    return count, err
+}
+
+// CountDuplicates - активные задачи пользователя с тем же текстом (без учёта регистра и пробелов по краям)
+func (r *taskRepository) CountDuplicates(ctx context.Context, userID, text string, excludeID int) (int, error) {
+    query := `SELECT COUNT(*) FROM "Tasks" 
+              WHERE user_id = $1 
+              AND id <> $3 AND deleted_at IS NULL
+              AND lower(trim(text)) = lower(trim($2))
+              AND status = ANY($4)`
+    var count int
+    err := r.conn(ctx).QueryRowContext(ctx, query, userID, text, excludeID, pq.Array(r.activeStatuses)).Scan(&count)
+    return count, err
+}
+
+// taskColumns - колонки, которые читает scanTask
+const taskColumns = `id, text, status, created_at, started_at, ended_at, updated_at, user_id, attempts, failure_reason, version,
+    priority, due_at, overdue_at, parent_id, project_id, deleted_at,
+    ARRAY(SELECT tg.name FROM task_tags tt JOIN tags tg ON tg.id = tt.tag_id WHERE tt.task_id = "Tasks".id ORDER BY tg.name),
+    ARRAY(SELECT m.user_id FROM task_members m WHERE m.task_id = "Tasks".id AND m.role = 'assignee' ORDER BY m.created_at, m.user_id),
+    ARRAY(SELECT m.user_id FROM task_members m WHERE m.task_id = "Tasks".id AND m.role = 'watcher' ORDER BY m.created_at, m.user_id)`
+
+type rowScanner interface {
+    Scan(dest ...interface{}) error
+}
+
+// scanTask читает строку с колонками taskColumns; extra - дополнительные колонки после них
+func scanTask(row rowScanner, extra ...interface{}) (models.Task, error) {
+    var t models.Task
+    var startedAt, endedAt, dueAt, overdueAt, deletedAt sql.NullTime
+    var parentID, projectID sql.NullInt64
+    var failureReason []byte
+
+    dest := append([]interface{}{
+        &t.ID, &t.Text, &t.Status, &t.CreatedAt, &startedAt, &endedAt,
+        &t.UpdatedAt, &t.UserID, &t.Attempts, &failureReason, &t.Version,
+        &t.Priority, &dueAt, &overdueAt, &parentID, &projectID, &deletedAt, pq.Array(&t.Tags),
+        pq.Array(&t.Assignees), pq.Array(&t.Watchers),
+    }, extra...)
+    if err := row.Scan(dest...); err != nil {
+        return t, err
+    }
+
+    if dueAt.Valid {
+        t.DueAt = &dueAt.Time
+    }
+    if overdueAt.Valid {
+        t.OverdueAt = &overdueAt.Time
+    }
+    if parentID.Valid {
+        id := int(parentID.Int64)
+        t.ParentID = &id
+    }
+    if projectID.Valid {
+        id := int(projectID.Int64)
+        t.ProjectID = &id
+    }
+    if deletedAt.Valid {
+        t.DeletedAt = &deletedAt.Time
+    }
+
+    t.FailureReason = decodeReason(failureReason)
+    if startedAt.Valid {
+        t.StartedAt = &startedAt.Time
+    }
+    if endedAt.Valid {
+        t.EndedAt = &endedAt.Time
+    }
+    return t, nil
+}
+
+func decodeReason(data []byte) *models.ValidationReason {
+    if len(data) == 0 {
+        return nil
+    }
+    var reason models.ValidationReason
+    if err := json.Unmarshal(data, &reason); err != nil {
+        log.Printf("[TaskRepository] bad failure_reason: %v", err)
+        return nil
+    }
+    return &reason
+}
+
+// visibleCond - задача видна пользователю placeholder: владелец, исполнитель или наблюдатель
+func visibleCond(placeholder string) string {
+    return `(user_id = ` + placeholder + ` OR EXISTS (
+        SELECT 1 FROM task_members m WHERE m.task_id = "Tasks".id AND m.user_id = ` + placeholder + `))`
+}
+
+// AddMember добавляет пользователя в исполнители или наблюдатели; false - уже был
+func (r *taskRepository) AddMember(ctx context.Context, taskID int, userID, role string) (bool, error) {
+    res, err := r.conn(ctx).ExecContext(ctx,
+        `INSERT INTO task_members (task_id, user_id, role) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`,
+        taskID, userID, role)
     if err != nil {
-        return nil, 0, fmt.Errorf("search query error: %w", err)
+        return false, err
+    }
+    r.wrote(ctx)
+    n, err := res.RowsAffected()
+    return n > 0, err
+}
+
+func (r *taskRepository) RemoveMember(ctx context.Context, taskID int, userID, role string) (bool, error) {
+    res, err := r.conn(ctx).ExecContext(ctx,
+        `DELETE FROM task_members WHERE task_id = $1 AND user_id = $2 AND role = $3`,
+        taskID, userID, role)
+    if err != nil {
+        return false, err
+    }
+    r.wrote(ctx)
+    n, err := res.RowsAffected()
+    return n > 0, err
+}
+
+// lockStatus блокирует строку задачи до конца транзакции и возвращает текущий статус
+func (r *taskRepository) lockStatus(ctx context.Context, id int) (string, error) {
+    var status string
+    err := r.conn(ctx).QueryRowContext(ctx, `SELECT status FROM "Tasks" WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, id).Scan(&status)
+    return status, err
+}
+
+// recordTransition пишет переход в историю; актор и причина берутся из контекста (WithActor)
+func (r *taskRepository) recordTransition(ctx context.Context, taskID int, from, to string) error {
+    if from == to {
+        return nil
+    }
+    actor, reason := actorFrom(ctx)
+    _, err := r.conn(ctx).ExecContext(ctx,
+        `INSERT INTO task_transitions (task_id, from_status, to_status, actor_type, actor_id, reason)
+         VALUES ($1, NULLIF($2, ''), $3, $4, NULLIF($5, ''), NULLIF($6, ''))`,
+        taskID, from, to, actor.Type, actor.ID, reason)
+    if err != nil {
+        return fmt.Errorf("record transition: %w", err)
+    }
+    return nil
+}
+
+// GetHistory возвращает историю переходов задачи в хронологическом порядке
+func (r *taskRepository) GetHistory(ctx context.Context, taskID int) ([]models.TaskTransition, error) {
+    rows, err := r.readConn(ctx).QueryContext(ctx,
+        `SELECT id, task_id, COALESCE(from_status, ''), to_status, actor_type, COALESCE(actor_id, ''), COALESCE(reason, ''), created_at
+         FROM task_transitions
+         WHERE task_id = $1
+         ORDER BY created_at, id`, taskID)
+    if err != nil {
+        return nil, err
     }
     defer rows.Close()
-    
-    var tasks []models.Task
+
+    var history []models.TaskTransition
     for rows.Next() {
-        var t models.Task
-        var startedAt, endedAt sql.NullTime
-        var rank float64
-        
-        if err := rows.Scan(
-            &t.ID, &t.Text, &t.Status, &t.CreatedAt,
-            &startedAt, &endedAt, &t.UserID, &rank,
-        ); err != nil {
-            return nil, 0, fmt.Errorf("scan error: %w", err)
-        }
-        
-        if startedAt.Valid {
-            t.StartedAt = &startedAt.Time
+        var t models.TaskTransition
+        if err := rows.Scan(&t.ID, &t.TaskID, &t.FromStatus, &t.ToStatus,
+            &t.Actor.Type, &t.Actor.ID, &t.Reason, &t.CreatedAt); err != nil {
+            return nil, err
         }
-        if endedAt.Valid {
-            t.EndedAt = &endedAt.Time
+        history = append(history, t)
+    }
+    return history, rows.Err()
+}
+
+// setTags заменяет теги задачи; новые теги создаются в справочнике tags
+func (r *taskRepository) setTags(ctx context.Context, taskID int, tags []string) error {
+    tags = normalizeTags(tags)
+    conn := r.conn(ctx)
+
+    if _, err := conn.ExecContext(ctx, `DELETE FROM task_tags WHERE task_id = $1`, taskID); err != nil {
+        return fmt.Errorf("clear tags: %w", err)
+    }
+    if len(tags) == 0 {
+        return nil
+    }
+    if _, err := conn.ExecContext(ctx,
+        `INSERT INTO tags (name) SELECT unnest($1::text[]) ON CONFLICT (name) DO NOTHING`,
+        pq.Array(tags)); err != nil {
+        return fmt.Errorf("create tags: %w", err)
+    }
+    if _, err := conn.ExecContext(ctx,
+        `INSERT INTO task_tags (task_id, tag_id) SELECT $1, id FROM tags WHERE name = ANY($2)`,
+        taskID, pq.Array(tags)); err != nil {
+        return fmt.Errorf("set tags: %w", err)
+    }
+    return nil
+}
+
+// normalizeTags - теги в нижнем регистре, без пробелов по краям, пустых и повторов
+func normalizeTags(tags []string) []string {
+    seen := make(map[string]bool, len(tags))
+    var out []string
+    for _, tag := range tags {
+        tag = strings.ToLower(strings.TrimSpace(tag))
+        if tag == "" || seen[tag] {
+            continue
         }
-        
-        tasks = append(tasks, t)
+        seen[tag] = true
+        out = append(out, tag)
     }
-    
-    // общее количество
-    var total int
-    err = r.db.QueryRowContext(ctx, countQuery, countArgs...).Scan(&total)
+    return out
+}
+
+// GetOverdue - просроченные задачи, о которых ещё не сообщали (кроме статусов excludeStatuses)
+func (r *taskRepository) GetOverdue(ctx context.Context, now time.Time, excludeStatuses []string) ([]models.Task, error) {
+    rows, err := r.conn(ctx).QueryContext(ctx,
+        `SELECT `+taskColumns+`
+         FROM "Tasks"
+         WHERE due_at < $1 AND overdue_at IS NULL AND deleted_at IS NULL AND NOT (status = ANY($2))
+         ORDER BY due_at, id`,
+        now, pq.Array(excludeStatuses))
     if err != nil {
-        return nil, 0, fmt.Errorf("count query error: %w", err)
+        return nil, err
     }
-    
-    return tasks, total, nil
+    defer rows.Close()
+
+    var tasks []models.Task
+    for rows.Next() {
+        t, err := scanTask(rows)
+        if err != nil {
+            return nil, err
+        }
+        tasks = append(tasks, t)
+    }
+    return tasks, rows.Err()
 }
 
-// IncrementAttempts увеличивает счётчик попыток валидации задачи
-func (r *taskRepository) IncrementAttempts(ctx context.Context, id int) error {
-    query := `UPDATE "Tasks" SET attempts = attempts + 1, updated_at = NOW() WHERE id = $1`
-    _, err := r.db.ExecContext(ctx, query, id)
-    return err
+// MarkOverdue отмечает просрочку и выставляет приоритет; false - задачу уже отметили
+func (r *taskRepository) MarkOverdue(ctx context.Context, id int, priority models.Priority) (bool, error) {
+    res, err := r.conn(ctx).ExecContext(ctx,
+        `UPDATE "Tasks"
+         SET overdue_at = NOW(), priority = $2, updated_at = NOW(), version = version + 1
+         WHERE id = $1 AND overdue_at IS NULL`,
+        id, priority)
+    if err != nil {
+        return false, err
+    }
+    n, err := res.RowsAffected()
+    return n > 0, err
 }
 
-func (r *taskRepository) GetActiveTasksCount(ctx context.Context, userID string) (int, error) {
-    query := `SELECT COUNT(*) FROM "Tasks" 
-              WHERE user_id = $1 
-              AND status IN ('NEW', 'VALIDATION_1', 'WAITING_FOR_VALIDATION_2', 'VALIDATION_2', 'READY_FOR_CLOSURE')`
+// CountOpenBlockers - сколько блокирующих задач и подзадач ещё не в doneStatuses
+func (r *taskRepository) CountOpenBlockers(ctx context.Context, taskID int, doneStatuses []string) (int, error) {
     var count int
-    err := r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+    err := r.conn(ctx).QueryRowContext(ctx,
+        `SELECT COUNT(*) FROM "Tasks" t
+         WHERE (t.id IN (SELECT blocked_by_id FROM task_dependencies WHERE task_id = $1) OR t.parent_id = $1)
+           AND t.deleted_at IS NULL AND NOT (t.status = ANY($2))`,
+        taskID, pq.Array(doneStatuses)).Scan(&count)
     return count, err
 }
+
+// AddDependency: taskID заблокирована blockedByID. Подзадачи тоже считаются блокерами
+// родителя, поэтому цикл ищется по обоим видам связей
+func (r *taskRepository) AddDependency(ctx context.Context, taskID, blockedByID int) error {
+    if taskID == blockedByID {
+        return fmt.Errorf("%w: task cannot block itself", ErrDependencyCycle)
+    }
+    return r.WithinTx(ctx, func(ctx context.Context) error {
+        conn := r.conn(ctx)
+        if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, dependencyLockKey); err != nil {
+            return err
+        }
+
+        // есть ли путь blockedByID → ... → taskID по рёбрам "заблокирована"
+        var cycle bool
+        err := conn.QueryRowContext(ctx,
+            `WITH RECURSIVE edges AS (
+                 SELECT task_id, blocked_by_id FROM task_dependencies
+                 UNION ALL
+                 SELECT parent_id, id FROM "Tasks" WHERE parent_id IS NOT NULL
+             ), chain AS (
+                 SELECT blocked_by_id AS id FROM edges WHERE task_id = $1
+                 UNION
+                 SELECT e.blocked_by_id FROM edges e JOIN chain c ON e.task_id = c.id
+             )
+             SELECT EXISTS (SELECT 1 FROM chain WHERE id = $2)`,
+            blockedByID, taskID).Scan(&cycle)
+        if err != nil {
+            return err
+        }
+        if cycle {
+            return fmt.Errorf("%w: task %d already depends on task %d", ErrDependencyCycle, blockedByID, taskID)
+        }
+
+        _, err = conn.ExecContext(ctx,
+            `INSERT INTO task_dependencies (task_id, blocked_by_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`,
+            taskID, blockedByID)
+        if err != nil {
+            return err
+        }
+        r.wrote(ctx)
+        return nil
+    })
+}
+
+// RemoveDependency - false, если такой зависимости не было
+func (r *taskRepository) RemoveDependency(ctx context.Context, taskID, blockedByID int) (bool, error) {
+    res, err := r.conn(ctx).ExecContext(ctx,
+        `DELETE FROM task_dependencies WHERE task_id = $1 AND blocked_by_id = $2`, taskID, blockedByID)
+    if err != nil {
+        return false, err
+    }
+    r.wrote(ctx)
+    n, err := res.RowsAffected()
+    return n > 0, err
+}
+
+// GetSubtree - задача и все её подзадачи (на любой глубине)
+func (r *taskRepository) GetSubtree(ctx context.Context, rootID int) ([]models.Task, error) {
+    rows, err := r.conn(ctx).QueryContext(ctx,
+        `WITH RECURSIVE tree AS (
+             SELECT id FROM "Tasks" WHERE id = $1 AND deleted_at IS NULL
+             UNION
+             SELECT t.id FROM "Tasks" t JOIN tree ON t.parent_id = tree.id WHERE t.deleted_at IS NULL
+         )
+         SELECT `+taskColumns+` FROM "Tasks" WHERE id IN (SELECT id FROM tree) ORDER BY id`, rootID)
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    var tasks []models.Task
+    for rows.Next() {
+        t, err := scanTask(rows)
+        if err != nil {
+            return nil, err
+        }
+        tasks = append(tasks, t)
+    }
+    return tasks, rows.Err()
+}
+
+// GetBlockers - блокирующие задачи для каждой из taskIDs
+func (r *taskRepository) GetBlockers(ctx context.Context, taskIDs []int) (map[int][]models.Task, error) {
+    ids := make([]int64, len(taskIDs))
+    for i, id := range taskIDs {
+        ids[i] = int64(id)
+    }
+    rows, err := r.conn(ctx).QueryContext(ctx,
+        `SELECT `+taskColumns+`, d.blocked_task_id
+         FROM "Tasks"
+         JOIN (SELECT task_id AS blocked_task_id, blocked_by_id FROM task_dependencies WHERE task_id = ANY($1)) d
+           ON "Tasks".id = d.blocked_by_id
+         WHERE "Tasks".deleted_at IS NULL
+         ORDER BY d.blocked_task_id, "Tasks".id`, pq.Array(ids))
+    if err != nil {
+        return nil, err
+    }
+    defer rows.Close()
+
+    blockers := make(map[int][]models.Task)
+    for rows.Next() {
+        var blockedID int
+        t, err := scanTask(rows, &blockedID)
+        if err != nil {
+            return nil, err
+        }
+        blockers[blockedID] = append(blockers[blockedID], t)
+    }
+    return blockers, rows.Err()
+}
@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-service/internal/models"
+	"task-service/internal/testdb"
+)
+
+func TestListCursor_DeletedAt(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	deleted := created.Add(48 * time.Hour)
+	task := &models.Task{ID: 7, CreatedAt: created, DeletedAt: &deleted}
+
+	c := listCursor(task, "deleted_at", "deleted_at DESC NULLS LAST")
+	if !c.Time.Equal(deleted) || c.Sort != "deleted_at DESC NULLS LAST" {
+		t.Errorf("cursor = %+v, want deleted_at %s", c, deleted)
+	}
+	if c := listCursor(task, "created_at", "created_at ASC NULLS LAST"); !c.Time.Equal(created) {
+		t.Errorf("cursor = %+v, want created_at %s", c, created)
+	}
+}
+
+// корзина по deleted_at: порядок удаления обратен порядку создания, страницы по курсору
+// не должны терять и повторять задачи
+func TestList_TrashPageToken(t *testing.T) {
+	db := testdb.Open(t)
+	ctx := context.Background()
+	r := NewTaskRepository(db, nil, nil)
+	userID := testdb.UserID(t, db)
+
+	var ids []int
+	for i := 0; i < 5; i++ {
+		id, err := r.Create(ctx, &models.Task{Text: "trash", Status: "NEW", UserID: userID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	// первая созданная удалена последней
+	for i, id := range ids {
+		_, err := db.Exec(`UPDATE "Tasks" SET deleted_at = NOW() - $2 * INTERVAL '1 minute' WHERE id = $1`, id, i+1)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []int
+	filter := TaskFilter{UserID: userID, Trashed: true, SortBy: "deleted_at", Limit: 2, SkipTotal: true}
+	for page := 0; page < 10; page++ {
+		p, err := r.List(ctx, filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, task := range p.Tasks {
+			got = append(got, task.ID)
+		}
+		if p.NextPageToken == "" {
+			break
+		}
+		filter.PageToken = p.NextPageToken
+	}
+
+	// по убыванию deleted_at - в порядке создания
+	if len(got) != len(ids) {
+		t.Fatalf("paged ids = %v, want %v", got, ids)
+	}
+	for i := range ids {
+		if got[i] != ids[i] {
+			t.Fatalf("paged ids = %v, want %v", got, ids)
+		}
+	}
+}
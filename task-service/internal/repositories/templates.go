@@ -0,0 +1,195 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+
+	"task-service/database"
+	"task-service/internal/models"
+)
+
+type TemplateRepository interface {
+	Create(ctx context.Context, tmpl *models.TaskTemplate) error
+	GetByID(ctx context.Context, id int) (*models.TaskTemplate, error)
+	ListByUser(ctx context.Context, userID string) ([]models.TaskTemplate, error)
+	Update(ctx context.Context, tmpl *models.TaskTemplate) error
+	Delete(ctx context.Context, id int) (bool, error)
+
+	// Due - id активных шаблонов, срабатывание которых наступило к now
+	Due(ctx context.Context, now time.Time, limit int) ([]int, error)
+	// LockDue блокирует шаблон до конца транзакции, если он всё ещё должен сработать;
+	// nil - шаблон уже обработан (или его обрабатывает другая реплика)
+	LockDue(ctx context.Context, id int, now time.Time) (*models.TaskTemplate, error)
+	// ClaimRun отмечает срабатывание шаблона; false - задача на это время уже создана
+	ClaimRun(ctx context.Context, templateID int, scheduledFor time.Time) (bool, error)
+	// CompleteRun привязывает созданную задачу к срабатыванию и переносит шаблон на next (nil - больше не сработает)
+	CompleteRun(ctx context.Context, templateID int, scheduledFor time.Time, taskID int, next *time.Time) error
+}
+
+type templateRepository struct {
+	db *sql.DB
+}
+
+func NewTemplateRepository(db *sql.DB) TemplateRepository {
+	return &templateRepository{db: db}
+}
+
+const templateColumns = `id, user_id, text, priority, tags, assignees, watchers, project_id,
+	schedule, timezone, due_in, paused, next_run_at, last_run_at, created_at, updated_at`
+
+func scanTemplate(row rowScanner) (models.TaskTemplate, error) {
+	var t models.TaskTemplate
+	var projectID sql.NullInt64
+	var dueIn int64
+	err := row.Scan(&t.ID, &t.UserID, &t.Text, &t.Priority, pq.Array(&t.Tags), pq.Array(&t.Assignees), pq.Array(&t.Watchers), &projectID,
+		&t.Schedule, &t.Timezone, &dueIn, &t.Paused, &t.NextRunAt, &t.LastRunAt, &t.CreatedAt, &t.UpdatedAt)
+	if err != nil {
+		return t, err
+	}
+	if projectID.Valid {
+		id := int(projectID.Int64)
+		t.ProjectID = &id
+	}
+	t.DueIn = time.Duration(dueIn) * time.Second
+	return t, nil
+}
+
+func (r *templateRepository) Create(ctx context.Context, tmpl *models.TaskTemplate) error {
+	if !tmpl.Priority.Valid() {
+		tmpl.Priority = models.PriorityMedium
+	}
+	return database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`INSERT INTO task_templates (user_id, text, priority, tags, assignees, watchers, project_id, schedule, timezone, due_in, paused, next_run_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		 RETURNING id, created_at, updated_at`,
+		tmpl.UserID, tmpl.Text, tmpl.Priority, pq.Array(tmpl.Tags), pq.Array(tmpl.Assignees), pq.Array(tmpl.Watchers), tmpl.ProjectID,
+		tmpl.Schedule, tmpl.Timezone, int64(tmpl.DueIn/time.Second), tmpl.Paused, tmpl.NextRunAt,
+	).Scan(&tmpl.ID, &tmpl.CreatedAt, &tmpl.UpdatedAt)
+}
+
+func (r *templateRepository) GetByID(ctx context.Context, id int) (*models.TaskTemplate, error) {
+	t, err := scanTemplate(database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+templateColumns+` FROM task_templates WHERE id = $1`, id))
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *templateRepository) ListByUser(ctx context.Context, userID string) ([]models.TaskTemplate, error) {
+	rows, err := database.Conn(ctx, r.db).QueryContext(ctx,
+		`SELECT `+templateColumns+` FROM task_templates WHERE user_id = $1 ORDER BY id`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []models.TaskTemplate
+	for rows.Next() {
+		t, err := scanTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, t)
+	}
+	return templates, rows.Err()
+}
+
+func (r *templateRepository) Update(ctx context.Context, tmpl *models.TaskTemplate) error {
+	if !tmpl.Priority.Valid() {
+		tmpl.Priority = models.PriorityMedium
+	}
+	res, err := database.Conn(ctx, r.db).ExecContext(ctx,
+		`UPDATE task_templates
+		 SET text = $2, priority = $3, tags = $4, assignees = $5, watchers = $6,
+		     schedule = $7, timezone = $8, due_in = $9, paused = $10, next_run_at = $11, updated_at = NOW()
+		 WHERE id = $1`,
+		tmpl.ID, tmpl.Text, tmpl.Priority, pq.Array(tmpl.Tags), pq.Array(tmpl.Assignees), pq.Array(tmpl.Watchers),
+		tmpl.Schedule, tmpl.Timezone, int64(tmpl.DueIn/time.Second), tmpl.Paused, tmpl.NextRunAt)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		if err == nil {
+			err = sql.ErrNoRows
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete удаляет шаблон; уже созданные по нему задачи остаются
+func (r *templateRepository) Delete(ctx context.Context, id int) (bool, error) {
+	res, err := database.Conn(ctx, r.db).ExecContext(ctx, `DELETE FROM task_templates WHERE id = $1`, id)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (r *templateRepository) Due(ctx context.Context, now time.Time, limit int) ([]int, error) {
+	rows, err := database.Conn(ctx, r.db).QueryContext(ctx,
+		`SELECT id FROM task_templates
+		 WHERE NOT paused AND next_run_at <= $1
+		 ORDER BY next_run_at
+		 LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *templateRepository) LockDue(ctx context.Context, id int, now time.Time) (*models.TaskTemplate, error) {
+	t, err := scanTemplate(database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+templateColumns+` FROM task_templates
+		 WHERE id = $1 AND NOT paused AND next_run_at <= $2
+		 FOR UPDATE SKIP LOCKED`, id, now))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *templateRepository) ClaimRun(ctx context.Context, templateID int, scheduledFor time.Time) (bool, error) {
+	res, err := database.Conn(ctx, r.db).ExecContext(ctx,
+		`INSERT INTO task_template_runs (template_id, scheduled_for) VALUES ($1, $2)
+		 ON CONFLICT DO NOTHING`, templateID, scheduledFor)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+func (r *templateRepository) CompleteRun(ctx context.Context, templateID int, scheduledFor time.Time, taskID int, next *time.Time) error {
+	conn := database.Conn(ctx, r.db)
+	if taskID > 0 {
+		if _, err := conn.ExecContext(ctx,
+			`UPDATE task_template_runs SET task_id = $3 WHERE template_id = $1 AND scheduled_for = $2`,
+			templateID, scheduledFor, taskID); err != nil {
+			return err
+		}
+	}
+	_, err := conn.ExecContext(ctx,
+		`UPDATE task_templates SET last_run_at = $2, next_run_at = $3 WHERE id = $1`,
+		templateID, scheduledFor, next)
+	return err
+}
@@ -0,0 +1,38 @@
+package repositories
+
+import (
+	"context"
+
+	"task-service/database"
+	"task-service/internal/models"
+)
+
+type actorKey struct{}
+
+type actorInfo struct {
+	actor  models.Actor
+	reason string
+}
+
+// WithActor помечает контекст: кто меняет задачу и почему. Попадает в историю переходов;
+// у чтений - кто читает (см. readDB)
+func WithActor(ctx context.Context, actor models.Actor, reason string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actorInfo{actor: actor, reason: reason})
+}
+
+func actorFrom(ctx context.Context) (models.Actor, string) {
+	if info, ok := ctx.Value(actorKey{}).(actorInfo); ok {
+		return info.actor, info.reason
+	}
+	return models.Actor{Type: models.ActorSystem}, ""
+}
+
+// conn возвращает текущую транзакцию из контекста или сам пул
+func (r *taskRepository) conn(ctx context.Context) database.DBTX {
+	return database.Conn(ctx, r.db)
+}
+
+// WithinTx выполняет fn в транзакции; вложенные вызовы переиспользуют уже открытую
+func (r *taskRepository) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return database.WithinTx(ctx, r.db, fn)
+}
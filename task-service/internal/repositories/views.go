@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+
+	"task-service/database"
+	"task-service/internal/models"
+)
+
+type ViewRepository interface {
+	Create(ctx context.Context, view *models.SavedView) error
+	GetByID(ctx context.Context, id int) (*models.SavedView, error)
+	ListByUser(ctx context.Context, userID string) ([]models.SavedView, error)
+	Update(ctx context.Context, view *models.SavedView) error
+	Delete(ctx context.Context, id int) (bool, error)
+}
+
+type viewRepository struct {
+	db *sql.DB
+}
+
+func NewViewRepository(db *sql.DB) ViewRepository {
+	return &viewRepository{db: db}
+}
+
+const viewColumns = `id, user_id, name, query, search_mode, language, filter, created_at, updated_at`
+
+func scanView(row rowScanner) (models.SavedView, error) {
+	var v models.SavedView
+	var filter []byte
+	err := row.Scan(&v.ID, &v.UserID, &v.Name, &v.Query, &v.SearchMode, &v.Language, &filter, &v.CreatedAt, &v.UpdatedAt)
+	v.Filter = filter
+	return v, err
+}
+
+// viewFilter - фильтр для колонки JSONB; пустой - {}
+func viewFilter(view *models.SavedView) []byte {
+	if len(view.Filter) == 0 {
+		return []byte("{}")
+	}
+	return view.Filter
+}
+
+func (r *viewRepository) Create(ctx context.Context, view *models.SavedView) error {
+	return database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`INSERT INTO saved_views (user_id, name, query, search_mode, language, filter)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id, created_at, updated_at`,
+		view.UserID, view.Name, view.Query, view.SearchMode, view.Language, viewFilter(view),
+	).Scan(&view.ID, &view.CreatedAt, &view.UpdatedAt)
+}
+
+func (r *viewRepository) GetByID(ctx context.Context, id int) (*models.SavedView, error) {
+	v, err := scanView(database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`SELECT `+viewColumns+` FROM saved_views WHERE id = $1`, id))
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (r *viewRepository) ListByUser(ctx context.Context, userID string) ([]models.SavedView, error) {
+	rows, err := database.Conn(ctx, r.db).QueryContext(ctx,
+		`SELECT `+viewColumns+` FROM saved_views WHERE user_id = $1 ORDER BY name, id`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var views []models.SavedView
+	for rows.Next() {
+		v, err := scanView(rows)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, v)
+	}
+	return views, rows.Err()
+}
+
+func (r *viewRepository) Update(ctx context.Context, view *models.SavedView) error {
+	err := database.Conn(ctx, r.db).QueryRowContext(ctx,
+		`UPDATE saved_views
+		 SET name = $2, query = $3, search_mode = $4, language = $5, filter = $6, updated_at = NOW()
+		 WHERE id = $1
+		 RETURNING updated_at`,
+		view.ID, view.Name, view.Query, view.SearchMode, view.Language, viewFilter(view),
+	).Scan(&view.UpdatedAt)
+	return err
+}
+
+func (r *viewRepository) Delete(ctx context.Context, id int) (bool, error) {
+	res, err := database.Conn(ctx, r.db).ExecContext(ctx, `DELETE FROM saved_views WHERE id = $1`, id)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
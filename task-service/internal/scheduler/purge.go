@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"task-service/internal/models"
+	"task-service/internal/repositories"
+)
+
+// за один тик очищается не больше стольких задач, остальные - на следующем
+const purgeBatch = 100
+
+type TrashRepository interface {
+	GetExpiredTrash(ctx context.Context, deletedBefore time.Time, limit int) ([]models.Task, error)
+	Purge(ctx context.Context, id int, deletedBefore time.Time) (bool, error)
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Purger окончательно удаляет задачи, пролежавшие в корзине дольше retention,
+// и отправляет PURGED. Подзадачи удаляются каскадом вместе с родителем
+type Purger struct {
+	repo      TrashRepository
+	outbox    EventOutbox
+	leader    Leader
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewPurger(repo TrashRepository, events EventOutbox, leader Leader, retention, interval time.Duration) *Purger {
+	return &Purger{
+		repo:      repo,
+		outbox:    events,
+		leader:    leader,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+func (p *Purger) Start(ctx context.Context) {
+	go func() {
+		log.Printf("[purger] started, retention %s", p.retention)
+		defer log.Println("[purger] stopped")
+
+		ticker := time.NewTicker(p.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if p.leader != nil && !p.leader.IsLeader() {
+					continue
+				}
+				p.purge(ctx, time.Now().Add(-p.retention))
+			case <-ctx.Done():
+				log.Println("[purger] received shutdown signal")
+				return
+			}
+		}
+	}()
+}
+
+func (p *Purger) purge(ctx context.Context, deletedBefore time.Time) {
+	dbCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	tasks, err := p.repo.GetExpiredTrash(dbCtx, deletedBefore, purgeBatch)
+	cancel()
+	if err != nil {
+		log.Printf("[purger] failed to get expired trash: %v\n", err)
+		return
+	}
+
+	ctx = repositories.WithActor(ctx, models.Actor{Type: models.ActorSystem}, "purge")
+	purged := 0
+	for _, task := range tasks {
+		// подзадача могла уйти каскадом вместе с родителем раньше в этом же цикле
+		var ok bool
+		err := p.repo.WithinTx(ctx, func(ctx context.Context) error {
+			var err error
+			if ok, err = p.repo.Purge(ctx, task.ID, deletedBefore); err != nil || !ok {
+				return err
+			}
+			return p.outbox.EnqueueTask(ctx, "PURGED", &task)
+		})
+		if err != nil {
+			log.Printf("[purger] task %d: %v\n", task.ID, err)
+			continue
+		}
+		if ok {
+			purged++
+		}
+	}
+	if purged > 0 {
+		log.Printf("[purger] purged %d tasks\n", purged)
+	}
+}
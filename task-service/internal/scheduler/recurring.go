@@ -0,0 +1,175 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"task-service/internal/models"
+	"task-service/internal/recurrence"
+	"task-service/internal/repositories"
+)
+
+// за один тик обрабатывается не больше стольких шаблонов, остальные - на следующем
+const recurringBatch = 100
+
+type TemplateRepository interface {
+	Due(ctx context.Context, now time.Time, limit int) ([]int, error)
+	LockDue(ctx context.Context, id int, now time.Time) (*models.TaskTemplate, error)
+	ClaimRun(ctx context.Context, templateID int, scheduledFor time.Time) (bool, error)
+	CompleteRun(ctx context.Context, templateID int, scheduledFor time.Time, taskID int, next *time.Time) error
+}
+
+type TaskCreator interface {
+	Create(ctx context.Context, task *models.Task) (int, error)
+	GetByID(ctx context.Context, id int) (*models.Task, error)
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// EventOutbox - outbox событий задач, пишет в транзакции из контекста
+type EventOutbox interface {
+	EnqueueTask(ctx context.Context, eventType string, task *models.Task) error
+}
+
+// Leader - выбор лидера между репликами; шаблоны обрабатывает только лидер
+type Leader interface {
+	IsLeader() bool
+}
+
+// Recurring создаёт задачи по шаблонам с расписанием. Срабатывание шаблона,
+// создание задачи и перенос next_run_at - одна транзакция, а task_template_runs
+// не даёт создать вторую задачу на то же время, поэтому перезапуски и
+// несколько реплик задачи не дублируют
+type Recurring struct {
+	templates TemplateRepository
+	tasks     TaskCreator
+	outbox    EventOutbox
+	leader    Leader
+	initial   string // начальный статус workflow
+	interval  time.Duration
+}
+
+func NewRecurring(templates TemplateRepository, tasks TaskCreator, events EventOutbox, leader Leader, initial string, interval time.Duration) *Recurring {
+	return &Recurring{
+		templates: templates,
+		tasks:     tasks,
+		outbox:    events,
+		leader:    leader,
+		initial:   initial,
+		interval:  interval,
+	}
+}
+
+func (r *Recurring) Start(ctx context.Context) {
+	go func() {
+		log.Println("[recurring] started")
+		defer log.Println("[recurring] stopped")
+
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if r.leader != nil && !r.leader.IsLeader() {
+					continue
+				}
+				r.materialize(ctx, time.Now())
+			case <-ctx.Done():
+				log.Println("[recurring] received shutdown signal")
+				return
+			}
+		}
+	}()
+}
+
+// materialize создаёт задачи по всем шаблонам, срабатывание которых наступило к now
+func (r *Recurring) materialize(ctx context.Context, now time.Time) {
+	dbCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	ids, err := r.templates.Due(dbCtx, now, recurringBatch)
+	cancel()
+	if err != nil {
+		log.Printf("[recurring] failed to get due templates: %v\n", err)
+		return
+	}
+
+	for _, id := range ids {
+		task, err := r.run(ctx, id, now)
+		if err != nil {
+			log.Printf("[recurring] template %d: %v\n", id, err)
+			continue
+		}
+		if task != nil {
+			log.Printf("[recurring] template %d: created task #%d\n", id, task.ID)
+		}
+	}
+}
+
+// run - одно срабатывание шаблона. Пропущенные срабатывания (сервис стоял)
+// не догоняются: создаётся одна задача, следующее срабатывание - после now
+func (r *Recurring) run(ctx context.Context, id int, now time.Time) (*models.Task, error) {
+	ctx = repositories.WithActor(ctx, models.Actor{Type: models.ActorScheduler, ID: strconv.Itoa(id)}, "recurring")
+
+	var created *models.Task
+	err := r.tasks.WithinTx(ctx, func(ctx context.Context) error {
+		tmpl, err := r.templates.LockDue(ctx, id, now)
+		if err != nil || tmpl == nil {
+			return err
+		}
+		scheduledFor := *tmpl.NextRunAt
+
+		var next *time.Time
+		schedule, err := recurrence.ParseIn(tmpl.Schedule, tmpl.Timezone)
+		if err != nil {
+			// расписание испортили в обход API - шаблон больше не срабатывает
+			log.Printf("[recurring] template %d: %v, disabled\n", id, err)
+			return r.templates.CompleteRun(ctx, id, scheduledFor, 0, nil)
+		}
+		if t := schedule.Next(now); !t.IsZero() {
+			next = &t
+		}
+
+		claimed, err := r.templates.ClaimRun(ctx, id, scheduledFor)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return r.templates.CompleteRun(ctx, id, scheduledFor, 0, next)
+		}
+
+		taskID, err := r.tasks.Create(ctx, r.newTask(tmpl, scheduledFor))
+		if err != nil {
+			return err
+		}
+		if created, err = r.tasks.GetByID(ctx, taskID); err != nil {
+			return err
+		}
+		if err := r.outbox.EnqueueTask(ctx, "CREATED", created); err != nil {
+			return err
+		}
+		return r.templates.CompleteRun(ctx, id, scheduledFor, taskID, next)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (r *Recurring) newTask(tmpl *models.TaskTemplate, scheduledFor time.Time) *models.Task {
+	task := &models.Task{
+		Text:      tmpl.Text,
+		UserID:    tmpl.UserID,
+		Status:    r.initial,
+		Priority:  tmpl.Priority,
+		Tags:      tmpl.Tags,
+		Assignees: tmpl.Assignees,
+		Watchers:  tmpl.Watchers,
+		ProjectID: tmpl.ProjectID,
+	}
+	if tmpl.DueIn > 0 {
+		dueAt := scheduledFor.Add(tmpl.DueIn).UTC()
+		task.DueAt = &dueAt
+	}
+	return task
+}
@@ -3,33 +3,46 @@ package statemachine
 import (
     "context"
     "log"
-    "strings"
     "time"
-    
+
     "task-service/internal/models"
+    "task-service/internal/outbox"
     "task-service/internal/repositories"
-    "task-service/internal/kafka"
+    "task-service/internal/workflow"
 )
 
+// Leader - выбор лидера между репликами; задачи двигает только лидер
+type Leader interface {
+    IsLeader() bool
+}
+
 type TaskStateMachine struct {
     repo     repositories.TaskRepository
-    producer *kafka.TaskEventProducer
+    outbox   *outbox.Store
+    engine   *workflow.Engine
+    leader   Leader
     ticker   *time.Ticker
     stopCh   chan struct{}
 }
 
-func NewTaskStateMachine(repo repositories.TaskRepository, producer *kafka.TaskEventProducer) *TaskStateMachine {
-    return &TaskStateMachine{
+func NewTaskStateMachine(repo repositories.TaskRepository, events *outbox.Store, engine *workflow.Engine, leader Leader) *TaskStateMachine {
+    sm := &TaskStateMachine{
         repo:     repo,
-        producer: producer,
+        outbox:   events,
+        engine:   engine,
+        leader:   leader,
         ticker:   time.NewTicker(30 * time.Second),
         stopCh:   make(chan struct{}),
     }
+    engine.RegisterAction("notify", sm.notifyAction)
+    return sm
 }
 
+// Start крутит тики до Stop или отмены ctx. Описание workflow проверяется при старте
+// сервиса (engine.Check после NewTaskStateMachine, которая регистрирует действие notify)
 func (sm *TaskStateMachine) Start(ctx context.Context) {
     log.Println("[StateMachine] Started")
-    
+
     for {
         select {
         case <-sm.ticker.C:
@@ -48,98 +61,158 @@ func (sm *TaskStateMachine) Stop() {
     close(sm.stopCh)
 }
 
+// processTasks обходит состояния в порядке описания workflow,
+// поэтому задача может пройти несколько auto-переходов за один тик
 func (sm *TaskStateMachine) processTasks(ctx context.Context) {
-    // 1. NEW → VALIDATION_1
-    newTasks, _ := sm.repo.GetByStatus(ctx, models.TaskStatusNew)
-    for _, task := range newTasks {
-        if sm.performValidation1(&task) {
-            sm.repo.UpdateStatus(ctx, task.ID, models.TaskStatusValidation1, nil, nil)
-            log.Printf("[StateMachine] Task %d: passed VALIDATION_1", task.ID)
-        } else {
-            sm.repo.UpdateStatus(ctx, task.ID, models.TaskStatusFailed, nil, nil)
-            log.Printf("[StateMachine] Task %d: failed VALIDATION_1", task.ID)
-        }
+    if !sm.isLeader() {
+        return
     }
-    
-    // 2. VALIDATION_1 → WAITING_FOR_VALIDATION_2
-    validation1Tasks, _ := sm.repo.GetByStatus(ctx, models.TaskStatusValidation1)
-    for _, task := range validation1Tasks {
-        sm.repo.UpdateStatus(ctx, task.ID, models.TaskStatusWaitingForValidation2, nil, nil)
-        log.Printf("[StateMachine] Task %d: → WAITING_FOR_VALIDATION_2", task.ID)
-    }
-    
-    // 3. Обработка WAITING_FOR_VALIDATION_2
-    waitingTasks, _ := sm.repo.GetByStatus(ctx, models.TaskStatusWaitingForValidation2)
-    for _, task := range waitingTasks {
-        if time.Since(task.CreatedAt) > 60*time.Minute {
-            sm.repo.Delete(ctx, task.ID)
-            log.Printf("[StateMachine] Task %d: expired, deleted", task.ID)
-            continue
-        }
-        
-        if task.Attempts >= 3 {
-            sm.repo.UpdateStatus(ctx, task.ID, models.TaskStatusFailed, nil, nil)
-            sm.sendNotification(ctx, &task, "TASK_FAILED", "Task failed after max attempts")
-            log.Printf("[StateMachine] Task %d: failed after %d attempts", task.ID, task.Attempts)
+    sm.processOverdue(ctx)
+    for _, state := range sm.engine.ActiveStates() {
+        tasks, err := sm.repo.GetByStatus(ctx, state)
+        if err != nil {
+            log.Printf("[StateMachine] Failed to get %s tasks: %v", state, err)
             continue
         }
-        
-        if sm.performValidation2(ctx, &task) {
-            sm.repo.UpdateStatus(ctx, task.ID, models.TaskStatusReadyForClosure, nil, nil)
-            sm.sendNotification(ctx, &task, "TASK_READY", "Task ready for closure")
-            log.Printf("[StateMachine] Task %d: passed VALIDATION_2 → READY_FOR_CLOSURE", task.ID)
-        } else {
-            sm.repo.IncrementAttempts(ctx, task.ID)
-            log.Printf("[StateMachine] Task %d: failed VALIDATION_2, attempt %d/3", task.ID, task.Attempts+1)
+        for _, task := range tasks {
+            // лидерство могло смениться посреди тика
+            if !sm.isLeader() {
+                log.Println("[StateMachine] Leadership lost, tick aborted")
+                return
+            }
+            sm.apply(ctx, &task, sm.engine.Evaluate(ctx, &task, time.Now()))
         }
     }
-    
-    // 4. READY_FOR_CLOSURE → CLOSED (автозакрытие через 1 час)
-    readyTasks, _ := sm.repo.GetByStatus(ctx, models.TaskStatusReadyForClosure)
-    for _, task := range readyTasks {
-        if time.Since(task.UpdatedAt) > 1*time.Hour {
-            sm.repo.UpdateStatus(ctx, task.ID, models.TaskStatusClosed, nil, nil)
-            sm.sendNotification(ctx, &task, "TASK_CLOSED", "Task auto-closed")
-            log.Printf("[StateMachine] Task %d: auto-closed", task.ID)
+}
+
+func (sm *TaskStateMachine) isLeader() bool {
+    return sm.leader == nil || sm.leader.IsLeader()
+}
+
+func (sm *TaskStateMachine) apply(ctx context.Context, task *models.Task, d workflow.Decision) {
+    ctx = repositories.WithActor(ctx, models.Actor{Type: models.ActorStateMachine}, decisionReason(d))
+
+    switch d.Kind {
+    case workflow.DecisionTransition:
+        // причина отказа, смена статуса (вместе с записью в историю) и события
+        // on_enter в outbox - одной транзакцией
+        from := task.Status
+        entered := *task
+        entered.Status = d.To
+        entered.FailureReason = d.Failure
+        err := sm.repo.WithinTx(ctx, func(ctx context.Context) error {
+            if err := sm.saveFailureReason(ctx, task, d.Failure); err != nil {
+                return err
+            }
+            if err := sm.repo.UpdateStatus(ctx, task.ID, d.To, nil, nil); err != nil {
+                return err
+            }
+            return sm.engine.Enter(ctx, &entered)
+        })
+        if err != nil {
+            log.Printf("[StateMachine] Task %d: failed to move to %s: %v", task.ID, d.To, err)
+            return
+        }
+        log.Printf("[StateMachine] Task %d: %s → %s %s", task.ID, from, d.To, d.Cause)
+        *task = entered
+
+    case workflow.DecisionRetry:
+        err := sm.repo.WithinTx(ctx, func(ctx context.Context) error {
+            if err := sm.saveFailureReason(ctx, task, d.Failure); err != nil {
+                return err
+            }
+            return sm.repo.IncrementAttempts(ctx, task.ID)
+        })
+        if err != nil {
+            log.Printf("[StateMachine] Task %d: failed to increment attempts: %v", task.ID, err)
+            return
+        }
+        task.FailureReason = d.Failure
+        log.Printf("[StateMachine] Task %d: %s validation failed (%s), attempt %d", task.ID, task.Status, reasonCode(d.Failure), task.Attempts+1)
+
+    case workflow.DecisionDelete:
+        // задача уходит в корзину (её можно восстановить до очистки), событие DELETED - в той же транзакции
+        err := sm.repo.WithinTx(ctx, func(ctx context.Context) error {
+            if err := sm.repo.Delete(ctx, task.ID); err != nil {
+                return err
+            }
+            return sm.outbox.EnqueueTask(ctx, "DELETED", task)
+        })
+        if err != nil {
+            log.Printf("[StateMachine] Task %d: failed to delete: %v", task.ID, err)
+            return
         }
+        log.Printf("[StateMachine] Task %d: expired, moved to trash", task.ID)
     }
 }
 
-func (sm *TaskStateMachine) performValidation1(task *models.Task) bool {
-    // Проверка: текст не пустой
-    if strings.TrimSpace(task.Text) == "" {
-        log.Printf("[StateMachine] Task %d: validation1 failed - empty text", task.ID)
-        return false
+// processOverdue отмечает просроченные задачи: поднимает приоритет и шлёт TASK_OVERDUE (один раз)
+func (sm *TaskStateMachine) processOverdue(ctx context.Context) {
+    def := sm.engine.Definition()
+    if def.Overdue == nil {
+        return
     }
-    // Проверка: текст не слишком длинный (максимум 1000 символов)
-    if len(task.Text) > 1000 {
-        log.Printf("[StateMachine] Task %d: validation1 failed - text too long (%d chars)", task.ID, len(task.Text))
-        return false
+    tasks, err := sm.repo.GetOverdue(ctx, time.Now(), def.TerminalStates())
+    if err != nil {
+        log.Printf("[StateMachine] Failed to get overdue tasks: %v", err)
+        return
+    }
+
+    eventType := def.Overdue.Event
+    if eventType == "" {
+        eventType = "TASK_OVERDUE"
+    }
+    ctx = repositories.WithActor(ctx, models.Actor{Type: models.ActorStateMachine}, "overdue")
+
+    for _, task := range tasks {
+        if !sm.isLeader() {
+            return
+        }
+        priority := task.Priority
+        if def.Overdue.Escalate {
+            priority = priority.Escalate()
+        }
+        err := sm.repo.WithinTx(ctx, func(ctx context.Context) error {
+            marked, err := sm.repo.MarkOverdue(ctx, task.ID, priority)
+            if err != nil || !marked {
+                return err
+            }
+            task.Priority = priority
+            return sm.outbox.EnqueueTask(ctx, eventType, &task)
+        })
+        if err != nil {
+            log.Printf("[StateMachine] Task %d: failed to mark overdue: %v", task.ID, err)
+            continue
+        }
+        log.Printf("[StateMachine] Task %d: overdue, priority %s", task.ID, priority)
     }
-    return true
 }
 
-func (sm *TaskStateMachine) performValidation2(ctx context.Context, task *models.Task) bool {
-    // Проверка лимита активных задач пользователя (максимум 5)
-    activeCount, err := sm.repo.GetActiveTasksCount(ctx, task.UserID)
-    if err != nil {
-        log.Printf("[StateMachine] Task %d: validation2 error - %v", task.ID, err)
-        return false
+// saveFailureReason сохраняет причину отказа валидатора на задаче,
+// а при успешном переходе очищает причину, оставшуюся от прошлых попыток
+func (sm *TaskStateMachine) saveFailureReason(ctx context.Context, task *models.Task, reason *models.ValidationReason) error {
+    if reason == nil && task.FailureReason == nil {
+        return nil
     }
-    if activeCount >= 5 {
-        log.Printf("[StateMachine] Task %d: validation2 failed - user has %d active tasks (max 5)", task.ID, activeCount)
-        return false
+    return sm.repo.SetFailureReason(ctx, task.ID, reason)
+}
+
+// decisionReason - текст причины для истории переходов
+func decisionReason(d workflow.Decision) string {
+    if d.Failure != nil {
+        return d.Failure.Code + ": " + d.Failure.Message
     }
-    
-    //  TODO: Проверка, есть ли свободные воркеры
-    // (можно добавить проверку очереди или количества активных воркеров)
-    // Для демо: всегда true
-    return true
+    return d.Cause
 }
 
-func (sm *TaskStateMachine) sendNotification(ctx context.Context, task *models.Task, eventType, message string) {
-    if sm.producer != nil {
-        go sm.producer.PublishTaskEvent(ctx, eventType, 
-            int32(task.ID), task.Text, task.Status, task.UserID)
+func reasonCode(reason *models.ValidationReason) string {
+    if reason == nil {
+        return "no reason"
     }
-}
\ No newline at end of file
+    return reason.Code
+}
+
+// notifyAction - on_enter действие "notify": кладёт событие с типом из params.event в outbox
+func (sm *TaskStateMachine) notifyAction(ctx context.Context, task *models.Task, params workflow.Params) error {
+    return sm.outbox.EnqueueTask(ctx, params.String("event", "UPDATED"), task)
+}
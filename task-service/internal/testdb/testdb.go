@@ -0,0 +1,49 @@
+// Package testdb - PostgreSQL для тестов, которым нужна настоящая база.
+// База задаётся TEST_DATABASE_DSN (postgres://...); без неё такие тесты пропускаются
+package testdb
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"task-service/database"
+	"task-service/migrations"
+)
+
+// Open подключается к TEST_DATABASE_DSN и применяет миграции. Тесты не чистят базу
+// целиком: свои данные они помечают уникальным user_id (UserID)
+func Open(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := database.NewMigrator(db, migrations.Files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return db
+}
+
+// UserID - уникальный владелец задач теста; его задачи удаляются после теста
+func UserID(t *testing.T, db *sql.DB) string {
+	t.Helper()
+	userID := t.Name() + "-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	t.Cleanup(func() {
+		db.Exec(`DELETE FROM "Tasks" WHERE user_id = $1`, userID)
+	})
+	return userID
+}
@@ -0,0 +1,219 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"task-service/internal/models"
+)
+
+// TaskStats - запросы к хранилищу, которые нужны встроенным валидаторам
+type TaskStats interface {
+	GetActiveTasksCount(ctx context.Context, userID string) (int, error)
+	GetActiveTasksCountInProject(ctx context.Context, userID string, projectID int) (int, error)
+	CountCreatedSince(ctx context.Context, userID string, since time.Time) (int, error)
+	CountDuplicates(ctx context.Context, userID, text string, excludeID int) (int, error)
+	CountOpenBlockers(ctx context.Context, taskID int, doneStatuses []string) (int, error)
+}
+
+// ProjectSettings - настройки проекта, которые переопределяют параметры валидаторов
+type ProjectSettings interface {
+	GetSettings(ctx context.Context, projectID int) (*models.ProjectSettings, error)
+}
+
+type Deps struct {
+	Tasks    TaskStats
+	Projects ProjectSettings // nil - настройки проектов не учитываются
+}
+
+// RegisterBuiltins регистрирует стандартные валидаторы
+func RegisterBuiltins(r *Registry, deps Deps) {
+	r.Register("text_not_empty", func(params Params) (Validator, error) {
+		return textNotEmpty{}, nil
+	})
+	r.Register("text_max_length", func(params Params) (Validator, error) {
+		return textMaxLength{max: params.Int("max", 1000)}, nil
+	})
+	r.Register("banned_words", func(params Params) (Validator, error) {
+		words := params.Strings("words")
+		if len(words) == 0 {
+			return nil, fmt.Errorf("words must not be empty")
+		}
+		return bannedWords{words: words}, nil
+	})
+	r.Register("active_tasks_limit", func(params Params) (Validator, error) {
+		return activeTasksLimit{tasks: deps.Tasks, projects: deps.Projects, max: params.Int("max", 5)}, nil
+	})
+	r.Register("user_quota", func(params Params) (Validator, error) {
+		period, err := params.Duration("period", 24*time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		return userQuota{tasks: deps.Tasks, max: params.Int("max", 100), period: period}, nil
+	})
+	r.Register("duplicate_text", func(params Params) (Validator, error) {
+		return duplicateText{tasks: deps.Tasks}, nil
+	})
+	r.Register("no_open_blockers", func(params Params) (Validator, error) {
+		done := params.Strings("done")
+		if len(done) == 0 {
+			done = []string{models.TaskStatusClosed}
+		}
+		return noOpenBlockers{tasks: deps.Tasks, done: done}, nil
+	})
+	r.Register("grpc_policy", newPolicyValidator)
+}
+
+type textNotEmpty struct{}
+
+func (textNotEmpty) Name() string { return "text_not_empty" }
+
+func (textNotEmpty) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	if strings.TrimSpace(task.Text) == "" {
+		return fail("EMPTY_TEXT", "task text is empty", nil), nil
+	}
+	return nil, nil
+}
+
+type textMaxLength struct {
+	max int
+}
+
+func (textMaxLength) Name() string { return "text_max_length" }
+
+func (v textMaxLength) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	if n := utf8.RuneCountInString(task.Text); n > v.max {
+		return fail("TEXT_TOO_LONG", fmt.Sprintf("task text is %d characters long, max is %d", n, v.max),
+			map[string]string{"length": strconv.Itoa(n), "max": strconv.Itoa(v.max)}), nil
+	}
+	return nil, nil
+}
+
+type bannedWords struct {
+	words []string
+}
+
+func (bannedWords) Name() string { return "banned_words" }
+
+func (v bannedWords) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	text := strings.ToLower(task.Text)
+	for _, w := range v.words {
+		if strings.Contains(text, strings.ToLower(w)) {
+			return fail("BANNED_WORD", "task text contains a banned word",
+				map[string]string{"word": w}), nil
+		}
+	}
+	return nil, nil
+}
+
+// activeTasksLimit - не больше max активных задач у пользователя.
+// Если у проекта задачи задан свой active_task_limit, считаются задачи пользователя в этом проекте
+type activeTasksLimit struct {
+	tasks    TaskStats
+	projects ProjectSettings
+	max      int
+}
+
+func (activeTasksLimit) Name() string { return "active_tasks_limit" }
+
+func (v activeTasksLimit) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	max, projectID, err := v.limit(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int
+	if projectID > 0 {
+		count, err = v.tasks.GetActiveTasksCountInProject(ctx, task.UserID, projectID)
+	} else {
+		count, err = v.tasks.GetActiveTasksCount(ctx, task.UserID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if count >= max {
+		details := map[string]string{"active": strconv.Itoa(count), "max": strconv.Itoa(max)}
+		if projectID > 0 {
+			details["project_id"] = strconv.Itoa(projectID)
+			return fail("ACTIVE_TASKS_LIMIT", fmt.Sprintf("user has %d active tasks in project %d, max is %d", count, projectID, max), details), nil
+		}
+		return fail("ACTIVE_TASKS_LIMIT", fmt.Sprintf("user has %d active tasks, max is %d", count, max), details), nil
+	}
+	return nil, nil
+}
+
+// limit - лимит из настроек проекта задачи, если он задан, иначе из параметров валидатора
+func (v activeTasksLimit) limit(ctx context.Context, task *models.Task) (int, int, error) {
+	if task.ProjectID == nil || v.projects == nil {
+		return v.max, 0, nil
+	}
+	settings, err := v.projects.GetSettings(ctx, *task.ProjectID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if settings.ActiveTaskLimit <= 0 {
+		return v.max, 0, nil
+	}
+	return settings.ActiveTaskLimit, *task.ProjectID, nil
+}
+
+type userQuota struct {
+	tasks  TaskStats
+	max    int
+	period time.Duration
+}
+
+func (userQuota) Name() string { return "user_quota" }
+
+func (v userQuota) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	count, err := v.tasks.CountCreatedSince(ctx, task.UserID, time.Now().Add(-v.period))
+	if err != nil {
+		return nil, err
+	}
+	if count > v.max {
+		return fail("USER_QUOTA_EXCEEDED", fmt.Sprintf("user created %d tasks in %s, quota is %d", count, v.period, v.max),
+			map[string]string{"created": strconv.Itoa(count), "quota": strconv.Itoa(v.max), "period": v.period.String()}), nil
+	}
+	return nil, nil
+}
+
+type duplicateText struct {
+	tasks TaskStats
+}
+
+func (duplicateText) Name() string { return "duplicate_text" }
+
+func (v duplicateText) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	count, err := v.tasks.CountDuplicates(ctx, task.UserID, task.Text, task.ID)
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return fail("DUPLICATE_TEXT", "user already has an active task with the same text", nil), nil
+	}
+	return nil, nil
+}
+
+// noOpenBlockers - у задачи не осталось незавершённых блокирующих задач и подзадач
+type noOpenBlockers struct {
+	tasks TaskStats
+	done  []string
+}
+
+func (noOpenBlockers) Name() string { return "no_open_blockers" }
+
+func (v noOpenBlockers) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	count, err := v.tasks.CountOpenBlockers(ctx, task.ID, v.done)
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return fail("BLOCKED", fmt.Sprintf("task has %d unfinished blockers or subtasks", count),
+			map[string]string{"open": strconv.Itoa(count)}), nil
+	}
+	return nil, nil
+}
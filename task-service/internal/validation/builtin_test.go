@@ -0,0 +1,199 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"task-service/internal/models"
+)
+
+// fakeStats - счётчики задач вместо базы
+type fakeStats struct {
+	active          int
+	activeInProject map[int]int
+	created         int
+	since           time.Time
+	duplicates      int
+	blockers        int
+	done            []string
+	err             error
+}
+
+func (s *fakeStats) GetActiveTasksCount(ctx context.Context, userID string) (int, error) {
+	return s.active, s.err
+}
+
+func (s *fakeStats) GetActiveTasksCountInProject(ctx context.Context, userID string, projectID int) (int, error) {
+	return s.activeInProject[projectID], s.err
+}
+
+func (s *fakeStats) CountCreatedSince(ctx context.Context, userID string, since time.Time) (int, error) {
+	s.since = since
+	return s.created, s.err
+}
+
+func (s *fakeStats) CountDuplicates(ctx context.Context, userID, text string, excludeID int) (int, error) {
+	return s.duplicates, s.err
+}
+
+func (s *fakeStats) CountOpenBlockers(ctx context.Context, taskID int, doneStatuses []string) (int, error) {
+	s.done = doneStatuses
+	return s.blockers, s.err
+}
+
+type fakeProjects map[int]*models.ProjectSettings
+
+func (p fakeProjects) GetSettings(ctx context.Context, projectID int) (*models.ProjectSettings, error) {
+	if s, ok := p[projectID]; ok {
+		return s, nil
+	}
+	return &models.ProjectSettings{}, nil
+}
+
+// build собирает встроенный валидатор по имени, как это делает workflow
+func build(t *testing.T, deps Deps, name string, params Params) Validator {
+	t.Helper()
+	r := NewRegistry()
+	RegisterBuiltins(r, deps)
+	v, err := r.Build(name, params)
+	if err != nil {
+		t.Fatalf("build %s: %v", name, err)
+	}
+	return v
+}
+
+// expectCode проверяет код отказа; "" - валидатор должен пропустить задачу
+func expectCode(t *testing.T, v Validator, task *models.Task, code string) *models.ValidationReason {
+	t.Helper()
+	reason, err := v.Validate(context.Background(), task)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", v.Name(), err)
+	}
+	switch {
+	case code == "" && reason != nil:
+		t.Fatalf("%s: expected pass, got %+v", v.Name(), reason)
+	case code != "" && (reason == nil || reason.Code != code):
+		t.Fatalf("%s: expected %s, got %+v", v.Name(), code, reason)
+	}
+	return reason
+}
+
+func TestTextNotEmpty(t *testing.T) {
+	v := build(t, Deps{}, "text_not_empty", nil)
+	expectCode(t, v, &models.Task{Text: " \n\t"}, "EMPTY_TEXT")
+	expectCode(t, v, &models.Task{Text: "buy milk"}, "")
+}
+
+func TestTextMaxLength(t *testing.T) {
+	v := build(t, Deps{}, "text_max_length", Params{"max": float64(5)})
+	// длина в символах, а не в байтах
+	expectCode(t, v, &models.Task{Text: "пять!"}, "")
+	reason := expectCode(t, v, &models.Task{Text: "привет"}, "TEXT_TOO_LONG")
+	if reason.Details["length"] != "6" || reason.Details["max"] != "5" {
+		t.Errorf("unexpected details %v", reason.Details)
+	}
+
+	if def := build(t, Deps{}, "text_max_length", nil).(textMaxLength); def.max != 1000 {
+		t.Errorf("expected default max 1000, got %d", def.max)
+	}
+}
+
+func TestBannedWords(t *testing.T) {
+	r := NewRegistry()
+	RegisterBuiltins(r, Deps{})
+	if _, err := r.Build("banned_words", Params{}); err == nil {
+		t.Error("expected error without words")
+	}
+
+	v := build(t, Deps{}, "banned_words", Params{"words": []interface{}{"spam"}})
+	reason := expectCode(t, v, &models.Task{Text: "Buy SPAM now"}, "BANNED_WORD")
+	if reason.Details["word"] != "spam" {
+		t.Errorf("expected word spam, got %v", reason.Details)
+	}
+	expectCode(t, v, &models.Task{Text: "buy milk"}, "")
+}
+
+func TestActiveTasksLimit(t *testing.T) {
+	project := 7
+	stats := &fakeStats{active: 5, activeInProject: map[int]int{project: 1}}
+	deps := Deps{Tasks: stats, Projects: fakeProjects{project: {ActiveTaskLimit: 2}}}
+	v := build(t, deps, "active_tasks_limit", Params{"max": float64(5)})
+
+	expectCode(t, v, &models.Task{UserID: "u1"}, "ACTIVE_TASKS_LIMIT")
+	stats.active = 4
+	expectCode(t, v, &models.Task{UserID: "u1"}, "")
+
+	// в проекте со своим лимитом считаются только задачи этого проекта
+	expectCode(t, v, &models.Task{UserID: "u1", ProjectID: &project}, "")
+	stats.activeInProject[project] = 2
+	reason := expectCode(t, v, &models.Task{UserID: "u1", ProjectID: &project}, "ACTIVE_TASKS_LIMIT")
+	if reason.Details["project_id"] != "7" || reason.Details["max"] != "2" {
+		t.Errorf("unexpected details %v", reason.Details)
+	}
+
+	// проект без своего лимита - общий лимит
+	other := 8
+	expectCode(t, v, &models.Task{UserID: "u1", ProjectID: &other}, "")
+
+	stats.err = errors.New("db down")
+	if _, err := v.Validate(context.Background(), &models.Task{UserID: "u1"}); err == nil {
+		t.Error("expected error from stats")
+	}
+}
+
+func TestUserQuota(t *testing.T) {
+	stats := &fakeStats{created: 3}
+	v := build(t, Deps{Tasks: stats}, "user_quota", Params{"max": float64(3), "period": "1h"})
+
+	before := time.Now()
+	expectCode(t, v, &models.Task{UserID: "u1"}, "")
+	if d := before.Sub(stats.since); d < time.Hour-time.Second || d > time.Hour+time.Second {
+		t.Errorf("expected period of 1h, counted since %s", stats.since)
+	}
+	stats.created = 4
+	expectCode(t, v, &models.Task{UserID: "u1"}, "USER_QUOTA_EXCEEDED")
+
+	r := NewRegistry()
+	RegisterBuiltins(r, Deps{Tasks: stats})
+	if _, err := r.Build("user_quota", Params{"period": "daily"}); err == nil {
+		t.Error("expected error for bad period")
+	}
+}
+
+func TestDuplicateText(t *testing.T) {
+	stats := &fakeStats{}
+	v := build(t, Deps{Tasks: stats}, "duplicate_text", nil)
+	expectCode(t, v, &models.Task{ID: 1, UserID: "u1", Text: "buy milk"}, "")
+	stats.duplicates = 1
+	expectCode(t, v, &models.Task{ID: 1, UserID: "u1", Text: "buy milk"}, "DUPLICATE_TEXT")
+}
+
+func TestNoOpenBlockers(t *testing.T) {
+	stats := &fakeStats{blockers: 2}
+	v := build(t, Deps{Tasks: stats}, "no_open_blockers", nil)
+	reason := expectCode(t, v, &models.Task{ID: 1}, "BLOCKED")
+	if reason.Details["open"] != "2" {
+		t.Errorf("unexpected details %v", reason.Details)
+	}
+	if strings.Join(stats.done, ",") != models.TaskStatusClosed {
+		t.Errorf("expected default done statuses [%s], got %v", models.TaskStatusClosed, stats.done)
+	}
+
+	stats.blockers = 0
+	v = build(t, Deps{Tasks: stats}, "no_open_blockers", Params{"done": []interface{}{"CLOSED", "FAILED"}})
+	expectCode(t, v, &models.Task{ID: 1}, "")
+	if strings.Join(stats.done, ",") != "CLOSED,FAILED" {
+		t.Errorf("expected done statuses from params, got %v", stats.done)
+	}
+}
+
+func TestGRPCPolicy_RequiresAddr(t *testing.T) {
+	r := NewRegistry()
+	RegisterBuiltins(r, Deps{})
+	if _, err := r.Build("grpc_policy", Params{}); err == nil {
+		t.Error("expected error without addr")
+	}
+}
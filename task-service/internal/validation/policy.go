@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	policypb "task-service/internal/grpc/policy/pb"
+	"task-service/internal/models"
+)
+
+// policyValidator спрашивает внешний PolicyService, можно ли пропустить задачу дальше
+type policyValidator struct {
+	client   policypb.PolicyServiceClient
+	policy   string
+	timeout  time.Duration
+	failOpen bool
+}
+
+func newPolicyValidator(params Params) (Validator, error) {
+	addr := params.String("addr", "")
+	if addr == "" {
+		return nil, fmt.Errorf("addr is required")
+	}
+	timeout, err := params.Duration("timeout", 2*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &policyValidator{
+		client:   policypb.NewPolicyServiceClient(conn),
+		policy:   params.String("policy", ""),
+		timeout:  timeout,
+		failOpen: params.Bool("fail_open", false),
+	}, nil
+}
+
+func (v *policyValidator) Name() string { return "grpc_policy" }
+
+func (v *policyValidator) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	callCtx, cancel := context.WithTimeout(ctx, v.timeout)
+	defer cancel()
+
+	resp, err := v.client.CheckTask(callCtx, &policypb.CheckTaskRequest{
+		TaskId: int32(task.ID),
+		UserId: task.UserID,
+		Text:   task.Text,
+		Status: task.Status,
+		Policy: v.policy,
+	})
+	if err != nil {
+		if v.failOpen {
+			log.Printf("[Validation] policy %q unavailable, skipping: %v", v.policy, err)
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if resp.GetAllowed() {
+		return nil, nil
+	}
+	code := resp.GetCode()
+	if code == "" {
+		code = "POLICY_REJECTED"
+	}
+	return fail(code, resp.GetMessage(), resp.GetDetails()), nil
+}
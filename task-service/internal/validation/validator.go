@@ -0,0 +1,123 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"task-service/internal/models"
+)
+
+// Validator - одно правило валидации задачи.
+// nil-причина означает, что задача правило прошла; error - сбой самой проверки
+type Validator interface {
+	Name() string
+	Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error)
+}
+
+// Factory создаёт валидатор по параметрам из описания workflow
+type Factory func(params Params) (Validator, error)
+
+// Chain - цепочка валидаторов, выполняется до первой неудачи
+type Chain []Validator
+
+func (c Chain) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	for _, v := range c {
+		reason, err := v.Validate(ctx, task)
+		if err != nil {
+			return nil, fmt.Errorf("validator %s: %w", v.Name(), err)
+		}
+		if reason != nil {
+			if reason.Validator == "" {
+				reason.Validator = v.Name()
+			}
+			return reason, nil
+		}
+	}
+	return nil, nil
+}
+
+type Registry struct {
+	mu        sync.RWMutex
+	factories map[string]Factory
+}
+
+func NewRegistry() *Registry {
+	return &Registry{factories: make(map[string]Factory)}
+}
+
+func (r *Registry) Register(name string, f Factory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.factories[name] = f
+}
+
+func (r *Registry) Build(name string, params Params) (Validator, error) {
+	r.mu.RLock()
+	f, ok := r.factories[name]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("validator %q is not registered", name)
+	}
+	v, err := f(params)
+	if err != nil {
+		return nil, fmt.Errorf("validator %q: %w", name, err)
+	}
+	return v, nil
+}
+
+// Params - параметры валидатора (или действия) из JSON-описания
+type Params map[string]interface{}
+
+func (p Params) Int(key string, def int) int {
+	switch v := p[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return def
+}
+
+func (p Params) String(key, def string) string {
+	if v, ok := p[key].(string); ok {
+		return v
+	}
+	return def
+}
+
+func (p Params) Bool(key string, def bool) bool {
+	if v, ok := p[key].(bool); ok {
+		return v
+	}
+	return def
+}
+
+func (p Params) Duration(key string, def time.Duration) (time.Duration, error) {
+	s, ok := p[key].(string)
+	if !ok {
+		return def, nil
+	}
+	return time.ParseDuration(s)
+}
+
+func (p Params) Strings(key string) []string {
+	var out []string
+	switch v := p[key].(type) {
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+	case []string:
+		out = v
+	}
+	return out
+}
+
+// fail - короткий конструктор причины отказа
+func fail(code, message string, details map[string]string) *models.ValidationReason {
+	return &models.ValidationReason{Code: code, Message: message, Details: details}
+}
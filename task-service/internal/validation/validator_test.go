@@ -0,0 +1,156 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"task-service/internal/models"
+)
+
+// stubValidator возвращает заданные причину и ошибку и считает вызовы
+type stubValidator struct {
+	name   string
+	reason *models.ValidationReason
+	err    error
+	calls  *int
+}
+
+func (v stubValidator) Name() string { return v.name }
+
+func (v stubValidator) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	*v.calls++
+	return v.reason, v.err
+}
+
+func TestChain_StopsAtFirstFailure(t *testing.T) {
+	var first, second, third int
+	chain := Chain{
+		stubValidator{name: "ok", calls: &first},
+		stubValidator{name: "bad", reason: fail("BAD", "bad", nil), calls: &second},
+		stubValidator{name: "never", calls: &third},
+	}
+
+	reason, err := chain.Validate(context.Background(), &models.Task{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reason == nil || reason.Code != "BAD" || reason.Validator != "bad" {
+		t.Fatalf("expected BAD from validator bad, got %+v", reason)
+	}
+	if first != 1 || second != 1 || third != 0 {
+		t.Errorf("expected calls 1/1/0, got %d/%d/%d", first, second, third)
+	}
+}
+
+func TestChain_KeepsValidatorSetByReason(t *testing.T) {
+	var calls int
+	reason := &models.ValidationReason{Code: "X", Validator: "inner"}
+	got, err := Chain{stubValidator{name: "outer", reason: reason, calls: &calls}}.Validate(context.Background(), &models.Task{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Validator != "inner" {
+		t.Errorf("expected validator inner, got %s", got.Validator)
+	}
+}
+
+func TestChain_WrapsError(t *testing.T) {
+	var calls, after int
+	boom := errors.New("boom")
+	chain := Chain{
+		stubValidator{name: "broken", err: boom, calls: &calls},
+		stubValidator{name: "after", calls: &after},
+	}
+
+	reason, err := chain.Validate(context.Background(), &models.Task{})
+	if !errors.Is(err, boom) || reason != nil {
+		t.Fatalf("expected wrapped boom and no reason, got %+v, %v", reason, err)
+	}
+	if after != 0 {
+		t.Error("chain must stop on error")
+	}
+}
+
+func TestChain_EmptyPasses(t *testing.T) {
+	if reason, err := Chain(nil).Validate(context.Background(), &models.Task{}); reason != nil || err != nil {
+		t.Fatalf("expected pass, got %+v, %v", reason, err)
+	}
+}
+
+func TestRegistry_Build(t *testing.T) {
+	r := NewRegistry()
+	r.Register("needs_max", func(params Params) (Validator, error) {
+		if params.Int("max", 0) <= 0 {
+			return nil, errors.New("max must be positive")
+		}
+		return textMaxLength{max: params.Int("max", 0)}, nil
+	})
+
+	if _, err := r.Build("missing", nil); err == nil {
+		t.Error("expected error for unregistered validator")
+	}
+	if _, err := r.Build("needs_max", Params{}); err == nil {
+		t.Error("expected factory error")
+	}
+	v, err := r.Build("needs_max", Params{"max": float64(3)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.(textMaxLength).max != 3 {
+		t.Errorf("expected max 3, got %+v", v)
+	}
+}
+
+func TestParams(t *testing.T) {
+	// параметры приходят из JSON: числа - float64, списки - []interface{}
+	var p Params
+	if err := json.Unmarshal([]byte(`{
+		"max": 7, "name": "x", "fail_open": true, "period": "90m", "bad_period": "soon",
+		"words": ["a", 1, "b"], "not_a_list": "c"
+	}`), &p); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := p.Int("max", 1); got != 7 {
+		t.Errorf("Int: expected 7, got %d", got)
+	}
+	if got := (Params{"max": 2}).Int("max", 1); got != 2 {
+		t.Errorf("Int from int: expected 2, got %d", got)
+	}
+	if got := p.Int("name", 1); got != 1 {
+		t.Errorf("Int of a string: expected default, got %d", got)
+	}
+	if got := p.String("name", "def"); got != "x" {
+		t.Errorf("String: expected x, got %s", got)
+	}
+	if got := p.String("missing", "def"); got != "def" {
+		t.Errorf("String: expected default, got %s", got)
+	}
+	if !p.Bool("fail_open", false) || p.Bool("missing", false) {
+		t.Error("Bool: expected true for fail_open and default for missing")
+	}
+
+	if d, err := p.Duration("period", time.Hour); err != nil || d != 90*time.Minute {
+		t.Errorf("Duration: expected 90m, got %s, %v", d, err)
+	}
+	if d, err := p.Duration("missing", time.Hour); err != nil || d != time.Hour {
+		t.Errorf("Duration: expected default, got %s, %v", d, err)
+	}
+	if _, err := p.Duration("bad_period", time.Hour); err == nil {
+		t.Error("Duration: expected parse error")
+	}
+
+	if got := p.Strings("words"); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Strings: expected [a b], got %v", got)
+	}
+	if got := (Params{"words": []string{"z"}}).Strings("words"); !reflect.DeepEqual(got, []string{"z"}) {
+		t.Errorf("Strings from []string: expected [z], got %v", got)
+	}
+	if got := p.Strings("not_a_list"); got != nil {
+		t.Errorf("Strings of a string: expected nil, got %v", got)
+	}
+}
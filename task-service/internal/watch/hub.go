@@ -0,0 +1,100 @@
+package watch
+
+import (
+	"log"
+	"sync"
+
+	events "task-service/proto/events"
+)
+
+// Filter - какие изменения нужны подписчику; пустые поля - без ограничения
+type Filter struct {
+	UserID string
+	Status string
+}
+
+func (f Filter) match(event *events.TaskEvent) bool {
+	if f.UserID != "" && !involves(event, f.UserID) {
+		return false
+	}
+	if f.Status != "" && event.TaskStatus != f.Status {
+		return false
+	}
+	return true
+}
+
+// involves - пользователь владелец, исполнитель или наблюдатель задачи из события
+func involves(event *events.TaskEvent, userID string) bool {
+	if event.UserId == userID {
+		return true
+	}
+	for _, id := range event.Assignees {
+		if id == userID {
+			return true
+		}
+	}
+	for _, id := range event.Watchers {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscription - подписка на изменения задач. Events закрывается,
+// когда подписчик отписался или не успевает читать
+type Subscription struct {
+	Events <-chan *events.TaskEvent
+
+	ch     chan *events.TaskEvent
+	filter Filter
+}
+
+// Hub раздаёт изменения задач подписчикам внутри процесса
+type Hub struct {
+	mu     sync.RWMutex
+	subs   map[*Subscription]struct{}
+	buffer int
+}
+
+func NewHub(buffer int) *Hub {
+	return &Hub{subs: make(map[*Subscription]struct{}), buffer: buffer}
+}
+
+func (h *Hub) Subscribe(filter Filter) *Subscription {
+	ch := make(chan *events.TaskEvent, h.buffer)
+	sub := &Subscription{Events: ch, ch: ch, filter: filter}
+
+	h.mu.Lock()
+	h.subs[sub] = struct{}{}
+	h.mu.Unlock()
+	return sub
+}
+
+func (h *Hub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// Publish не блокируется: медленный подписчик отключается, а не тормозит остальных
+func (h *Hub) Publish(event *events.TaskEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs {
+		if !sub.filter.match(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			log.Printf("[watch] subscriber is too slow, disconnecting")
+			delete(h.subs, sub)
+			close(sub.ch)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package watch
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/lib/pq"
+
+	events "task-service/proto/events"
+)
+
+// EventSource - откуда читать события по id из NOTIFY (outbox.Store);
+// у пакетной записи событий несколько
+type EventSource interface {
+	Events(ctx context.Context, id int64) ([]*events.TaskEvent, error)
+}
+
+// Listen слушает NOTIFY из outbox и раздаёт события в hub.
+// Так изменения видят подписчики всех реплик, а не только той, где они произошли
+func Listen(ctx context.Context, dsn, channel string, source EventSource, hub *Hub) error {
+	listener := pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("[watch] listener: %v", err)
+		}
+	})
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return err
+	}
+
+	go func() {
+		log.Printf("[watch] listening on %s", channel)
+		defer log.Println("[watch] listener stopped")
+		defer listener.Close()
+
+		for {
+			select {
+			case n := <-listener.Notify:
+				// nil после переподключения: пропущенные за это время изменения не восстановить
+				if n == nil {
+					log.Println("[watch] listener reconnected, some changes may be missed")
+					continue
+				}
+				id, err := strconv.ParseInt(n.Extra, 10, 64)
+				if err != nil {
+					log.Printf("[watch] bad notification payload %q", n.Extra)
+					continue
+				}
+				batch, err := source.Events(ctx, id)
+				if err != nil {
+					log.Printf("[watch] failed to load event %d: %v", id, err)
+					continue
+				}
+				for _, event := range batch {
+					hub.Publish(event)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nil
+}
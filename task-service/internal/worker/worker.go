@@ -3,16 +3,23 @@ package worker
 import (
 	"task-service/internal/models"
 	// "task-service/internal/metrics"
-	"task-service/internal/kafka"
+	"task-service/internal/repositories"
 	"fmt"
 	"log"
 	"math/rand"
+	"strconv"
 	"time"
 	"context"
 )
 
 type TaskRepository interface {
 	UpdateStatus(ctx context.Context, id int, status string, startedAt, endedAt *time.Time) error
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// EventOutbox - outbox событий задач, пишет в транзакции из контекста
+type EventOutbox interface {
+	EnqueueTask(ctx context.Context, eventType string, task *models.Task) error
 }
 
 type TaskQueue interface {
@@ -28,11 +35,11 @@ type Worker struct {
 	Repo TaskRepository
 	Queue TaskQueue
 	notifier Notifier
-	producer *kafka.TaskEventProducer
+	outbox EventOutbox
 }
 
-func NewWorker(id int, repo TaskRepository, queue TaskQueue, n Notifier, producer *kafka.TaskEventProducer) *Worker {
-	return &Worker{ID: id, Repo: repo, Queue: queue, notifier: n, producer: producer}
+func NewWorker(id int, repo TaskRepository, queue TaskQueue, n Notifier, events EventOutbox) *Worker {
+	return &Worker{ID: id, Repo: repo, Queue: queue, notifier: n, outbox: events}
 }
 
 func (w *Worker) Start(ctx context.Context) {
@@ -50,7 +57,10 @@ func (w *Worker) Start(ctx context.Context) {
 					start := time.Now()
 					log.Printf("[Worker %d] Started processing task #%d (%s) at %v\n", w.ID, task.ID, task.Text, start)
 
-					err := w.Repo.UpdateStatus(ctx, task.ID, "processing", &start, nil)
+					// смены статуса воркером попадают в историю с актором worker
+					taskCtx := repositories.WithActor(ctx, models.Actor{Type: models.ActorWorker, ID: strconv.Itoa(w.ID)}, "")
+
+					err := w.Repo.UpdateStatus(taskCtx, task.ID, "processing", &start, nil)
 					if err != nil {
 						log.Printf("[Worker %d] Failed to update task #%d to 'processing': %v", w.ID, task.ID, err)
 						continue
@@ -69,7 +79,18 @@ func (w *Worker) Start(ctx context.Context) {
 					time.Sleep(processTime)
 
 					end := time.Now()
-					err = w.Repo.UpdateStatus(ctx, task.ID, "completed", nil, &end)
+					// статус completed и событие COMPLETED - одной транзакцией
+					err = w.Repo.WithinTx(taskCtx, func(ctx context.Context) error {
+						if err := w.Repo.UpdateStatus(ctx, task.ID, "completed", nil, &end); err != nil {
+							return err
+						}
+						if w.outbox == nil {
+							return nil
+						}
+						completed := task
+						completed.Status = "completed"
+						return w.outbox.EnqueueTask(ctx, "COMPLETED", &completed)
+					})
 
 					//сбор метрики
 					// metrics.Get().IncTasksCompleted()
@@ -89,17 +110,6 @@ func (w *Worker) Start(ctx context.Context) {
 					// 	Timestamp: end,
 					// })
 
-					if w.producer != nil {
-					go func() {
-							kafkaCtx := context.Background()
-							err := w.producer.PublishTaskEvent(kafkaCtx, "COMPLETED",
-								int32(task.ID), task.Text, "completed", task.UserID)
-							if err != nil {
-								log.Printf("[Worker %d] Failed to publish COMPLETED event: %v", w.ID, err)
-							}
-						}()
-					}
-
 					log.Printf("[Worker %d] Completed task processing #%d (%s) at %v\n", w.ID, task.ID, task.Text, end)
 					fmt.Println("----------------------------------------")
 				case <-ctx.Done():
@@ -35,6 +35,10 @@ func (f *fakeRepo) UpdateStatus(ctx context.Context, id int, status string, star
 	return nil
 }
 
+func (f *fakeRepo) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 func (f *fakeRepo) Calls() []statusCall {
 	f.mu.Lock()
 	defer f.mu.Unlock()
@@ -0,0 +1,237 @@
+package workflow
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"task-service/internal/validation"
+)
+
+//go:embed default.json
+var defaultDefinition []byte
+
+var (
+	ErrUnknownState      = errors.New("unknown state")
+	ErrIllegalTransition = errors.New("illegal transition")
+)
+
+// Definition - описание workflow: состояния, переходы, таймауты и лимиты попыток
+type Definition struct {
+	Initial string   `json:"initial"`
+	States  []State  `json:"states"`
+	Overdue *Overdue `json:"overdue,omitempty"`
+
+	index map[string]*State
+}
+
+type State struct {
+	Name        string       `json:"name"`
+	Terminal    bool         `json:"terminal,omitempty"`
+	Timeout     *Timeout     `json:"timeout,omitempty"`
+	Retry       *Retry       `json:"retry,omitempty"`
+	OnEnter     []ActionRef  `json:"on_enter,omitempty"`
+	Transitions []Transition `json:"transitions,omitempty"`
+}
+
+// Transition - разрешённый переход. Auto-переходы выполняет state machine
+// (первый, у которого прошла вся цепочка валидаторов), остальные доступны пользователю.
+// Guards проверяются до валидаторов: не прошедший guard не считается неудачной
+// попыткой (retry), задача просто ждёт, пока условие выполнится
+type Transition struct {
+	To         string         `json:"to"`
+	Auto       bool           `json:"auto,omitempty"`
+	Guards     []ValidatorRef `json:"guards,omitempty"`
+	Validators []ValidatorRef `json:"validators,omitempty"`
+}
+
+// Timeout - что делать с задачей, которая слишком долго находится в состоянии
+type Timeout struct {
+	After  Duration `json:"after"`
+	Since  string   `json:"since,omitempty"` // created_at или updated_at
+	To     string   `json:"to,omitempty"`
+	Action string   `json:"action,omitempty"` // delete
+}
+
+// Retry - лимит попыток auto-перехода, после которого задача уходит в To
+type Retry struct {
+	MaxAttempts int    `json:"max_attempts"`
+	To          string `json:"to"`
+}
+
+// Overdue - что делать с незавершёнными задачами, у которых прошёл due_at
+type Overdue struct {
+	Escalate bool   `json:"escalate,omitempty"` // поднять приоритет на один уровень
+	Event    string `json:"event,omitempty"`    // по умолчанию TASK_OVERDUE
+}
+
+type ValidatorRef struct {
+	Name   string `json:"name"`
+	Params Params `json:"params,omitempty"`
+}
+
+type ActionRef struct {
+	Action string `json:"action"`
+	Params Params `json:"params,omitempty"`
+}
+
+const (
+	SinceCreatedAt = "created_at"
+	SinceUpdatedAt = "updated_at"
+
+	TimeoutActionDelete = "delete"
+)
+
+// Duration - time.Duration, которая читается из JSON строкой вида "60m"
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	d.Duration = v
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// Params - параметры валидатора или action'а из файла описания
+type Params = validation.Params
+
+// Default возвращает встроенное описание, повторяющее прежнюю логику state machine
+func Default() (*Definition, error) {
+	return Parse(defaultDefinition)
+}
+
+// LoadFile читает описание из JSON-файла
+func LoadFile(path string) (*Definition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read workflow file: %w", err)
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) (*Definition, error) {
+	var def Definition
+	if err := json.Unmarshal(data, &def); err != nil {
+		return nil, fmt.Errorf("parse workflow: %w", err)
+	}
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// Validate проверяет целостность описания и строит индекс состояний
+func (d *Definition) Validate() error {
+	d.index = make(map[string]*State, len(d.States))
+	for i := range d.States {
+		s := &d.States[i]
+		if s.Name == "" {
+			return fmt.Errorf("workflow: state #%d has no name", i)
+		}
+		if _, dup := d.index[s.Name]; dup {
+			return fmt.Errorf("workflow: duplicate state %s", s.Name)
+		}
+		d.index[s.Name] = s
+	}
+
+	if _, ok := d.index[d.Initial]; !ok {
+		return fmt.Errorf("workflow: initial state %q: %w", d.Initial, ErrUnknownState)
+	}
+
+	for _, s := range d.States {
+		if s.Terminal && (len(s.Transitions) > 0 || s.Timeout != nil || s.Retry != nil) {
+			return fmt.Errorf("workflow: terminal state %s must not have transitions, timeout or retry", s.Name)
+		}
+		for _, t := range s.Transitions {
+			if _, ok := d.index[t.To]; !ok {
+				return fmt.Errorf("workflow: %s → %q: %w", s.Name, t.To, ErrUnknownState)
+			}
+		}
+		if t := s.Timeout; t != nil {
+			if t.After.Duration <= 0 {
+				return fmt.Errorf("workflow: state %s: timeout must be positive", s.Name)
+			}
+			if t.Since != "" && t.Since != SinceCreatedAt && t.Since != SinceUpdatedAt {
+				return fmt.Errorf("workflow: state %s: unsupported timeout since %q", s.Name, t.Since)
+			}
+			if (t.To == "") == (t.Action == "") {
+				return fmt.Errorf("workflow: state %s: timeout needs exactly one of to/action", s.Name)
+			}
+			if t.Action != "" && t.Action != TimeoutActionDelete {
+				return fmt.Errorf("workflow: state %s: unsupported timeout action %q", s.Name, t.Action)
+			}
+			if _, ok := d.index[t.To]; t.To != "" && !ok {
+				return fmt.Errorf("workflow: state %s timeout → %q: %w", s.Name, t.To, ErrUnknownState)
+			}
+		}
+		if r := s.Retry; r != nil {
+			if r.MaxAttempts <= 0 {
+				return fmt.Errorf("workflow: state %s: max_attempts must be positive", s.Name)
+			}
+			if _, ok := d.index[r.To]; !ok {
+				return fmt.Errorf("workflow: state %s retry → %q: %w", s.Name, r.To, ErrUnknownState)
+			}
+		}
+	}
+	return nil
+}
+
+// TerminalStates - конечные состояния (задачи в них не просрочиваются)
+func (d *Definition) TerminalStates() []string {
+	var names []string
+	for _, s := range d.States {
+		if s.Terminal {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+// NonTerminalStates - состояния, в которых задача ещё в работе (активна)
+func (d *Definition) NonTerminalStates() []string {
+	var names []string
+	for _, s := range d.States {
+		if !s.Terminal {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+func (d *Definition) State(name string) (*State, bool) {
+	s, ok := d.index[name]
+	return s, ok
+}
+
+// CanTransition проверяет ручной (пользовательский) переход from → to.
+// Auto-переходы пользователю недоступны: их выполняет только state machine
+func (d *Definition) CanTransition(from, to string) error {
+	s, ok := d.index[from]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownState, from)
+	}
+	if _, ok := d.index[to]; !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownState, to)
+	}
+	for _, t := range s.Transitions {
+		if t.To == to && !t.Auto {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s → %s", ErrIllegalTransition, from, to)
+}
@@ -0,0 +1,271 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"task-service/internal/models"
+	"task-service/internal/validation"
+)
+
+// Action - действие при входе в состояние (on_enter).
+// Выполняется в транзакции перехода: ошибка откатывает переход
+type Action func(ctx context.Context, task *models.Task, params Params) error
+
+type DecisionKind int
+
+const (
+	DecisionStay DecisionKind = iota
+	DecisionTransition
+	DecisionRetry
+	DecisionDelete
+)
+
+// Decision - что state machine должна сделать с задачей на текущем тике.
+// Failure - причина, по которой не прошла цепочка валидаторов (если была)
+type Decision struct {
+	Kind    DecisionKind
+	To      string
+	Cause   string
+	Failure *models.ValidationReason
+}
+
+type Engine struct {
+	def        *Definition
+	validators *validation.Registry
+
+	mu      sync.RWMutex
+	chains  map[string][]validation.Chain // состояние → цепочка для каждого перехода
+	guards  map[string][]validation.Chain // состояние → guards для каждого перехода
+	actions map[string]Action
+}
+
+func NewEngine(def *Definition, validators *validation.Registry) *Engine {
+	return &Engine{
+		def:        def,
+		validators: validators,
+		chains:     make(map[string][]validation.Chain),
+		guards:     make(map[string][]validation.Chain),
+		actions:    make(map[string]Action),
+	}
+}
+
+func (e *Engine) Definition() *Definition {
+	return e.def
+}
+
+func (e *Engine) RegisterAction(name string, a Action) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.actions[name] = a
+}
+
+// Check собирает цепочки валидаторов и проверяет, что все actions зарегистрированы
+func (e *Engine) Check() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	chains := make(map[string][]validation.Chain, len(e.def.States))
+	guards := make(map[string][]validation.Chain, len(e.def.States))
+	for _, s := range e.def.States {
+		for _, a := range s.OnEnter {
+			if _, ok := e.actions[a.Action]; !ok {
+				return fmt.Errorf("workflow: state %s: action %q is not registered", s.Name, a.Action)
+			}
+		}
+		stateChains := make([]validation.Chain, len(s.Transitions))
+		stateGuards := make([]validation.Chain, len(s.Transitions))
+		for i, t := range s.Transitions {
+			var err error
+			if stateChains[i], err = e.buildChain(t.Validators); err != nil {
+				return fmt.Errorf("workflow: %s → %s: %w", s.Name, t.To, err)
+			}
+			if stateGuards[i], err = e.buildChain(t.Guards); err != nil {
+				return fmt.Errorf("workflow: %s → %s guard: %w", s.Name, t.To, err)
+			}
+		}
+		chains[s.Name] = stateChains
+		guards[s.Name] = stateGuards
+	}
+	e.chains = chains
+	e.guards = guards
+	return nil
+}
+
+func (e *Engine) buildChain(refs []ValidatorRef) (validation.Chain, error) {
+	var chain validation.Chain
+	for _, ref := range refs {
+		v, err := e.validators.Build(ref.Name, ref.Params)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, v)
+	}
+	return chain, nil
+}
+
+// ActiveStates - состояния, которые state machine должна обходить на каждом тике
+func (e *Engine) ActiveStates() []string {
+	var names []string
+	for _, s := range e.def.States {
+		if s.Timeout != nil || s.Retry != nil || hasAuto(s.Transitions) {
+			names = append(names, s.Name)
+		}
+	}
+	return names
+}
+
+// CanTransition проверяет переход, инициированный пользователем
+func (e *Engine) CanTransition(from, to string) error {
+	return e.def.CanTransition(from, to)
+}
+
+// Guard проверяет guards ручного перехода задачи в to; nil - переход разрешён
+func (e *Engine) Guard(ctx context.Context, task *models.Task, to string) (*models.ValidationReason, error) {
+	s, ok := e.def.State(task.Status)
+	if !ok {
+		return nil, nil
+	}
+
+	e.mu.RLock()
+	guards := e.guards[s.Name]
+	e.mu.RUnlock()
+
+	for i, t := range s.Transitions {
+		if t.To != to || t.Auto || i >= len(guards) {
+			continue
+		}
+		return guards[i].Validate(ctx, task)
+	}
+	return nil, nil
+}
+
+// Evaluate решает, что делать с задачей: таймаут, лимит попыток, auto-переход или повтор
+func (e *Engine) Evaluate(ctx context.Context, task *models.Task, now time.Time) Decision {
+	s, ok := e.def.State(task.Status)
+	if !ok || s.Terminal {
+		return Decision{Kind: DecisionStay}
+	}
+
+	if t := s.Timeout; t != nil {
+		since := task.CreatedAt
+		if t.Since == SinceUpdatedAt {
+			since = task.UpdatedAt
+		}
+		if now.Sub(since) > t.After.Duration {
+			if t.Action == TimeoutActionDelete {
+				return Decision{Kind: DecisionDelete, Cause: "timeout"}
+			}
+			return Decision{Kind: DecisionTransition, To: t.To, Cause: "timeout"}
+		}
+	}
+
+	if r := s.Retry; r != nil && task.Attempts >= r.MaxAttempts {
+		return Decision{Kind: DecisionTransition, To: r.To, Cause: "max attempts", Failure: maxAttemptsReason(task, r.MaxAttempts)}
+	}
+
+	e.mu.RLock()
+	chains := e.chains[s.Name]
+	guards := e.guards[s.Name]
+	e.mu.RUnlock()
+
+	// причина отказа первой не прошедшей цепочки - её получит fallback-переход или повтор.
+	// Ошибка валидатора или guard'а (таймаут, сбой БД) - не отказ: задача ждёт следующего тика,
+	// а не уходит в fallback-переход вроде FAILED
+	var failure, blocked *models.ValidationReason
+	for i, t := range s.Transitions {
+		if !t.Auto {
+			continue
+		}
+		if (len(t.Validators) > 0 || len(t.Guards) > 0) && (i >= len(chains) || i >= len(guards)) {
+			log.Printf("[Workflow] %s → %s: validators are not built, call Check first", s.Name, t.To)
+			return Decision{Kind: DecisionStay, Cause: "error"}
+		}
+
+		if len(t.Guards) > 0 {
+			reason, err := guards[i].Validate(ctx, task)
+			if err != nil {
+				log.Printf("[Workflow] Task %d: %s → %s guard: %v", task.ID, s.Name, t.To, err)
+				return Decision{Kind: DecisionStay, Cause: "error"}
+			}
+			if reason != nil {
+				if blocked == nil {
+					blocked = reason
+				}
+				continue
+			}
+		}
+
+		var reason *models.ValidationReason
+		if len(t.Validators) > 0 {
+			var err error
+			reason, err = chains[i].Validate(ctx, task)
+			if err != nil {
+				log.Printf("[Workflow] Task %d: %s → %s: %v", task.ID, s.Name, t.To, err)
+				return Decision{Kind: DecisionStay, Cause: "error"}
+			}
+		}
+		if reason == nil {
+			return Decision{Kind: DecisionTransition, To: t.To, Failure: failure}
+		}
+		if failure == nil {
+			failure = reason
+		}
+	}
+
+	// переход закрыт guard'ом, а не валидацией - ждём без траты попыток
+	if blocked != nil && failure == nil {
+		return Decision{Kind: DecisionStay, Cause: "blocked", Failure: blocked}
+	}
+
+	if s.Retry != nil && hasAuto(s.Transitions) {
+		return Decision{Kind: DecisionRetry, Failure: failure}
+	}
+	return Decision{Kind: DecisionStay, Failure: failure}
+}
+
+// Enter выполняет on_enter действия текущего состояния задачи
+func (e *Engine) Enter(ctx context.Context, task *models.Task) error {
+	s, ok := e.def.State(task.Status)
+	if !ok {
+		return nil
+	}
+	for _, ref := range s.OnEnter {
+		e.mu.RLock()
+		action, ok := e.actions[ref.Action]
+		e.mu.RUnlock()
+		if !ok {
+			log.Printf("[Workflow] action %q is not registered", ref.Action)
+			continue
+		}
+		if err := action(ctx, task, ref.Params); err != nil {
+			return fmt.Errorf("on_enter %s in %s: %w", ref.Action, s.Name, err)
+		}
+	}
+	return nil
+}
+
+func maxAttemptsReason(task *models.Task, max int) *models.ValidationReason {
+	reason := &models.ValidationReason{
+		Code:    "MAX_ATTEMPTS_EXCEEDED",
+		Message: fmt.Sprintf("validation failed %d times", max),
+	}
+	if last := task.FailureReason; last != nil {
+		reason.Message += ": " + last.Message
+		reason.Validator = last.Validator
+		reason.Details = map[string]string{"last_code": last.Code}
+	}
+	return reason
+}
+
+func hasAuto(ts []Transition) bool {
+	for _, t := range ts {
+		if t.Auto {
+			return true
+		}
+	}
+	return false
+}
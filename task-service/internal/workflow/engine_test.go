@@ -0,0 +1,193 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"task-service/internal/models"
+	"task-service/internal/validation"
+)
+
+// фейковый валидатор: отказывает, если его имя помечено false в pass;
+// с ошибкой err - не может проверить (как при таймауте или сбое БД)
+type fakeValidator struct {
+	name string
+	pass map[string]bool
+	err  error
+}
+
+func (v fakeValidator) Name() string { return v.name }
+
+func (v fakeValidator) Validate(ctx context.Context, task *models.Task) (*models.ValidationReason, error) {
+	if v.err != nil {
+		return nil, v.err
+	}
+	if ok, set := v.pass[v.name]; set && !ok {
+		return &models.ValidationReason{Code: "FAKE", Message: v.name + " failed"}, nil
+	}
+	return nil, nil
+}
+
+func newTestEngine(t *testing.T, pass map[string]bool) *Engine {
+	t.Helper()
+	return newTestEngineWithErrors(t, pass, nil)
+}
+
+// newTestEngineWithErrors - как newTestEngine, но валидаторы из errs возвращают ошибку
+func newTestEngineWithErrors(t *testing.T, pass map[string]bool, errs map[string]error) *Engine {
+	t.Helper()
+
+	def, err := Default()
+	if err != nil {
+		t.Fatalf("default workflow: %v", err)
+	}
+
+	reg := validation.NewRegistry()
+	for _, name := range []string{"text_not_empty", "text_max_length", "active_tasks_limit", "no_open_blockers"} {
+		name := name
+		reg.Register(name, func(params validation.Params) (validation.Validator, error) {
+			return fakeValidator{name: name, pass: pass, err: errs[name]}, nil
+		})
+	}
+
+	e := NewEngine(def, reg)
+	e.RegisterAction("notify", func(ctx context.Context, task *models.Task, params Params) error { return nil })
+
+	if err := e.Check(); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	return e
+}
+
+func TestEngine_NewTaskGoesToValidation1OrFailed(t *testing.T) {
+	now := time.Now()
+	task := &models.Task{ID: 1, Status: models.TaskStatusNew, CreatedAt: now, UpdatedAt: now}
+
+	d := newTestEngine(t, nil).Evaluate(context.Background(), task, now)
+	if d.Kind != DecisionTransition || d.To != models.TaskStatusValidation1 {
+		t.Fatalf("expected transition to %s, got %+v", models.TaskStatusValidation1, d)
+	}
+
+	d = newTestEngine(t, map[string]bool{"text_not_empty": false}).Evaluate(context.Background(), task, now)
+	if d.Kind != DecisionTransition || d.To != models.TaskStatusFailed {
+		t.Fatalf("expected transition to %s, got %+v", models.TaskStatusFailed, d)
+	}
+	if d.Failure == nil || d.Failure.Validator != "text_not_empty" {
+		t.Fatalf("expected failure reason from text_not_empty, got %+v", d.Failure)
+	}
+}
+
+// временная ошибка валидатора не должна отправлять задачу в fallback FAILED
+func TestEngine_ValidatorErrorStays(t *testing.T) {
+	now := time.Now()
+	task := &models.Task{ID: 1, Status: models.TaskStatusNew, CreatedAt: now, UpdatedAt: now}
+
+	e := newTestEngineWithErrors(t, nil, map[string]error{"text_max_length": errors.New("policy timeout")})
+	if d := e.Evaluate(context.Background(), task, now); d.Kind != DecisionStay {
+		t.Fatalf("expected stay on validator error, got %+v", d)
+	}
+
+	// и guard с ошибкой: ни перехода, ни траты попытки
+	task.Status = models.TaskStatusWaitingForValidation2
+	e = newTestEngineWithErrors(t, nil, map[string]error{"no_open_blockers": errors.New("db down")})
+	if d := e.Evaluate(context.Background(), task, now); d.Kind != DecisionStay {
+		t.Fatalf("expected stay on guard error, got %+v", d)
+	}
+}
+
+func TestEngine_WaitingForValidation2(t *testing.T) {
+	now := time.Now()
+	e := newTestEngine(t, map[string]bool{"active_tasks_limit": false})
+
+	task := &models.Task{ID: 1, Status: models.TaskStatusWaitingForValidation2, CreatedAt: now, UpdatedAt: now}
+	d := e.Evaluate(context.Background(), task, now)
+	if d.Kind != DecisionRetry || d.Failure == nil {
+		t.Errorf("expected retry with failure reason, got %+v", d)
+	}
+
+	task.Attempts = 3
+	task.FailureReason = d.Failure
+	d = e.Evaluate(context.Background(), task, now)
+	if d.Kind != DecisionTransition || d.To != models.TaskStatusFailed {
+		t.Errorf("expected transition to FAILED after max attempts, got %+v", d)
+	}
+	if d.Failure == nil || d.Failure.Code != "MAX_ATTEMPTS_EXCEEDED" || d.Failure.Details["last_code"] != "FAKE" {
+		t.Errorf("expected MAX_ATTEMPTS_EXCEEDED with last code, got %+v", d.Failure)
+	}
+
+	task.CreatedAt = now.Add(-61 * time.Minute)
+	if d := e.Evaluate(context.Background(), task, now); d.Kind != DecisionDelete {
+		t.Errorf("expected delete on timeout, got %+v", d)
+	}
+}
+
+func TestEngine_BlockedTaskWaitsWithoutRetry(t *testing.T) {
+	now := time.Now()
+	e := newTestEngine(t, map[string]bool{"no_open_blockers": false})
+
+	task := &models.Task{ID: 1, Status: models.TaskStatusWaitingForValidation2, CreatedAt: now, UpdatedAt: now}
+	d := e.Evaluate(context.Background(), task, now)
+	if d.Kind != DecisionStay || d.Failure == nil {
+		t.Fatalf("expected blocked task to stay without retry, got %+v", d)
+	}
+
+	// неудачная валидация всё так же тратит попытку
+	e = newTestEngine(t, map[string]bool{"active_tasks_limit": false})
+	if d := e.Evaluate(context.Background(), task, now); d.Kind != DecisionRetry {
+		t.Fatalf("expected retry, got %+v", d)
+	}
+}
+
+func TestEngine_ReadyForClosureAutoCloses(t *testing.T) {
+	now := time.Now()
+	e := newTestEngine(t, nil)
+
+	task := &models.Task{ID: 1, Status: models.TaskStatusReadyForClosure, CreatedAt: now, UpdatedAt: now}
+	if d := e.Evaluate(context.Background(), task, now); d.Kind != DecisionStay {
+		t.Errorf("expected stay, got %+v", d)
+	}
+
+	task.UpdatedAt = now.Add(-2 * time.Hour)
+	if d := e.Evaluate(context.Background(), task, now); d.Kind != DecisionTransition || d.To != models.TaskStatusClosed {
+		t.Errorf("expected auto-close, got %+v", d)
+	}
+}
+
+func TestDefinition_CanTransition(t *testing.T) {
+	def, err := Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := def.CanTransition(models.TaskStatusReadyForClosure, models.TaskStatusClosed); err != nil {
+		t.Errorf("manual close must be allowed: %v", err)
+	}
+	if err := def.CanTransition(models.TaskStatusNew, models.TaskStatusValidation1); !errors.Is(err, ErrIllegalTransition) {
+		t.Errorf("auto transition must not be allowed to users, got %v", err)
+	}
+	if err := def.CanTransition(models.TaskStatusNew, "DONE"); !errors.Is(err, ErrUnknownState) {
+		t.Errorf("expected ErrUnknownState, got %v", err)
+	}
+}
+
+func TestDefinition_NonTerminalStates(t *testing.T) {
+	def, err := Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Join(def.NonTerminalStates(), ",")
+	want := "NEW,VALIDATION_1,WAITING_FOR_VALIDATION_2,READY_FOR_CLOSURE"
+	if got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestParse_RejectsUnknownTarget(t *testing.T) {
+	_, err := Parse([]byte(`{"initial":"NEW","states":[{"name":"NEW","transitions":[{"to":"ON_HOLD"}]}]}`))
+	if !errors.Is(err, ErrUnknownState) {
+		t.Fatalf("expected ErrUnknownState, got %v", err)
+	}
+}
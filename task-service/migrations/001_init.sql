@@ -0,0 +1,23 @@
+-- исходная схема: задачи и пользователи. На базе, созданной до миграций,
+-- таблицы уже есть - добавляются только недостающие колонки state machine
+CREATE TABLE IF NOT EXISTS "Tasks" (
+    id         SERIAL PRIMARY KEY,
+    text       TEXT NOT NULL,
+    status     TEXT NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    started_at TIMESTAMPTZ,
+    ended_at   TIMESTAMPTZ,
+    user_id    TEXT NOT NULL DEFAULT ''
+);
+
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS attempts INT DEFAULT 0;
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS validation1_at TIMESTAMPTZ;
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS validation2_at TIMESTAMPTZ;
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS closed_at TIMESTAMPTZ;
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS users (
+    id       SERIAL PRIMARY KEY,
+    username TEXT NOT NULL UNIQUE,
+    password TEXT NOT NULL
+);
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS tasks_search_vector_trigger ON "Tasks";
+DROP FUNCTION IF EXISTS tasks_search_vector_update();
+DROP INDEX IF EXISTS idx_tasks_search_vector;
+ALTER TABLE "Tasks" DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,2 @@
+-- причина, по которой задача не прошла валидацию (models.ValidationReason)
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS failure_reason JSONB;
@@ -0,0 +1,13 @@
+-- история смены статусов задач
+CREATE TABLE IF NOT EXISTS task_transitions (
+    id          SERIAL PRIMARY KEY,
+    task_id     INT NOT NULL REFERENCES "Tasks"(id) ON DELETE CASCADE,
+    from_status TEXT,
+    to_status   TEXT NOT NULL,
+    actor_type  TEXT NOT NULL,  -- user, state_machine, worker, system
+    actor_id    TEXT,
+    reason      TEXT,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_transitions_task_id ON task_transitions(task_id, created_at);
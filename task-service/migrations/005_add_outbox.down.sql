@@ -0,0 +1,2 @@
+-- неотправленные события теряются
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,15 @@
+-- transactional outbox: события пишутся в одной транзакции с изменением задачи,
+-- relay публикует их в Kafka и помечает отправленными
+CREATE TABLE IF NOT EXISTS outbox (
+    id              BIGSERIAL PRIMARY KEY,
+    task_id         INT NOT NULL,
+    event_type      TEXT NOT NULL,
+    payload         BYTEA NOT NULL,  -- events.TaskEvent в protobuf
+    created_at      TIMESTAMP NOT NULL DEFAULT NOW(),
+    attempts        INT NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent_at         TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at, id) WHERE sent_at IS NULL;
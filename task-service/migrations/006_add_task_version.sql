@@ -0,0 +1,2 @@
+-- optimistic locking: version увеличивается при каждом изменении задачи
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
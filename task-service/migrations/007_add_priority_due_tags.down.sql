@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS task_tags;
+DROP TABLE IF EXISTS tags;
+DROP INDEX IF EXISTS idx_tasks_due_at;
+DROP INDEX IF EXISTS idx_tasks_priority;
+ALTER TABLE "Tasks" DROP COLUMN IF EXISTS overdue_at;
+ALTER TABLE "Tasks" DROP COLUMN IF EXISTS due_at;
+ALTER TABLE "Tasks" DROP COLUMN IF EXISTS priority;
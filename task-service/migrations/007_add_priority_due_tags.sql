@@ -0,0 +1,22 @@
+-- приоритет: 1 LOW, 2 MEDIUM, 3 HIGH, 4 CRITICAL (как enum Priority в task.proto)
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS priority SMALLINT NOT NULL DEFAULT 2
+    CHECK (priority BETWEEN 1 AND 4);
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS due_at TIMESTAMP;
+-- когда state machine отметила просрочку (TASK_OVERDUE отправляется один раз)
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS overdue_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_priority ON "Tasks"(priority);
+CREATE INDEX IF NOT EXISTS idx_tasks_due_at ON "Tasks"(due_at) WHERE due_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS tags (
+    id   SERIAL PRIMARY KEY,
+    name TEXT NOT NULL UNIQUE
+);
+
+CREATE TABLE IF NOT EXISTS task_tags (
+    task_id INT NOT NULL REFERENCES "Tasks"(id) ON DELETE CASCADE,
+    tag_id  INT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (task_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_tags_tag_id ON task_tags(tag_id);
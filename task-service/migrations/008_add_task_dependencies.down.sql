@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_dependencies;
+DROP INDEX IF EXISTS idx_tasks_parent_id;
+ALTER TABLE "Tasks" DROP COLUMN IF EXISTS parent_id;
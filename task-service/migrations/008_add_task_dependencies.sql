@@ -0,0 +1,14 @@
+-- подзадачи: удаляются вместе с родительской задачей
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS parent_id INT REFERENCES "Tasks"(id) ON DELETE CASCADE;
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON "Tasks"(parent_id) WHERE parent_id IS NOT NULL;
+
+-- task_id нельзя завершить, пока не завершена blocked_by_id
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id       INT NOT NULL REFERENCES "Tasks"(id) ON DELETE CASCADE,
+    blocked_by_id INT NOT NULL REFERENCES "Tasks"(id) ON DELETE CASCADE,
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (task_id, blocked_by_id),
+    CHECK (task_id <> blocked_by_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocked_by ON task_dependencies(blocked_by_id);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS task_comments (
+    id         SERIAL PRIMARY KEY,
+    task_id    INT NOT NULL REFERENCES "Tasks"(id) ON DELETE CASCADE,
+    user_id    TEXT NOT NULL,
+    body       TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP  -- NULL, если комментарий не редактировали
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_comments_task_id ON task_comments(task_id, created_at, id);
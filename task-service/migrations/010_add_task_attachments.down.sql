@@ -0,0 +1,2 @@
+-- файлы вложений в BlobStore остаются, их нужно удалить отдельно
+DROP TABLE IF EXISTS task_attachments;
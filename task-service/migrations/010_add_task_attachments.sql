@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS task_attachments (
+    id           SERIAL PRIMARY KEY,
+    task_id      INT NOT NULL REFERENCES "Tasks"(id) ON DELETE CASCADE,
+    user_id      TEXT NOT NULL,          -- кто загрузил, по нему считается квота
+    name         TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size         BIGINT NOT NULL,
+    checksum     TEXT NOT NULL,          -- sha256, hex
+    storage_key  TEXT NOT NULL UNIQUE,   -- ключ в BlobStore
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_attachments_task_id ON task_attachments(task_id);
+CREATE INDEX IF NOT EXISTS idx_task_attachments_user_id ON task_attachments(user_id);
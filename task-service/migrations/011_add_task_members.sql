@@ -0,0 +1,11 @@
+-- исполнители и наблюдатели задачи (владелец - "Tasks".user_id)
+CREATE TABLE IF NOT EXISTS task_members (
+    task_id    INT NOT NULL REFERENCES "Tasks"(id) ON DELETE CASCADE,
+    user_id    TEXT NOT NULL,
+    role       TEXT NOT NULL CHECK (role IN ('assignee', 'watcher')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (task_id, role, user_id)
+);
+
+-- GET /me/assigned, GET /me/watching и проверка видимости
+CREATE INDEX IF NOT EXISTS idx_task_members_user ON task_members(user_id, role);
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS projects (
+    id         SERIAL PRIMARY KEY,
+    name       TEXT NOT NULL,
+    owner_id   TEXT NOT NULL,
+    settings   JSONB NOT NULL DEFAULT '{}',  -- настройки workflow проекта (active_task_limit ...)
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS project_members (
+    project_id INT NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id    TEXT NOT NULL,
+    role       TEXT NOT NULL CHECK (role IN ('owner', 'editor', 'viewer')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_members_user ON project_members(user_id);
+
+-- проект с задачами удалить нельзя (FK без каскада)
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS project_id INT REFERENCES projects(id);
+CREATE INDEX IF NOT EXISTS idx_tasks_project_id ON "Tasks"(project_id, created_at);
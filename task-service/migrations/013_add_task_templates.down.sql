@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS task_template_runs;
+DROP TABLE IF EXISTS task_templates;
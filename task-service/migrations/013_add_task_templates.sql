@@ -0,0 +1,32 @@
+-- шаблоны повторяющихся задач: scheduler создаёт по ним задачи по расписанию
+CREATE TABLE IF NOT EXISTS task_templates (
+    id          SERIAL PRIMARY KEY,
+    user_id     TEXT NOT NULL,
+    text        TEXT NOT NULL,
+    priority    SMALLINT NOT NULL DEFAULT 2 CHECK (priority BETWEEN 1 AND 4),
+    tags        TEXT[] NOT NULL DEFAULT '{}',
+    assignees   TEXT[] NOT NULL DEFAULT '{}',
+    watchers    TEXT[] NOT NULL DEFAULT '{}',
+    project_id  INT REFERENCES projects(id) ON DELETE CASCADE,
+    schedule    TEXT NOT NULL,                 -- cron: "0 9 * * mon-fri", @daily ...
+    timezone    TEXT NOT NULL DEFAULT 'UTC',   -- в каком поясе считается расписание
+    due_in      INT NOT NULL DEFAULT 0,        -- срок задачи: через столько секунд после срабатывания, 0 - без срока
+    paused      BOOLEAN NOT NULL DEFAULT FALSE,
+    next_run_at TIMESTAMPTZ,                   -- NULL - расписание больше не сработает
+    last_run_at TIMESTAMPTZ,
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_templates_user_id ON task_templates(user_id);
+CREATE INDEX IF NOT EXISTS idx_task_templates_next_run ON task_templates(next_run_at) WHERE NOT paused;
+
+-- срабатывания шаблонов: не больше одной задачи на (шаблон, время по расписанию)
+-- при перезапусках и на любой реплике
+CREATE TABLE IF NOT EXISTS task_template_runs (
+    template_id   INT NOT NULL REFERENCES task_templates(id) ON DELETE CASCADE,
+    scheduled_for TIMESTAMPTZ NOT NULL,
+    task_id       INT REFERENCES "Tasks"(id) ON DELETE SET NULL,
+    created_at    TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (template_id, scheduled_for)
+);
@@ -0,0 +1,4 @@
+-- задачи из корзины становятся обычными: удалите их до отката, если это не нужно
+DROP INDEX IF EXISTS idx_tasks_status_alive;
+DROP INDEX IF EXISTS idx_tasks_deleted_at;
+ALTER TABLE "Tasks" DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,6 @@
+-- корзина: удалённая задача хранится до очистки (TRASH_RETENTION)
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_deleted_at ON "Tasks"(deleted_at) WHERE deleted_at IS NOT NULL;
+-- выборки state machine и dispatcher идут только по живым задачам
+CREATE INDEX IF NOT EXISTS idx_tasks_status_alive ON "Tasks"(status) WHERE deleted_at IS NULL;
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_tasks_text_prefix;
+DROP INDEX IF EXISTS idx_tasks_attempts;
+DROP INDEX IF EXISTS idx_tasks_ended_at;
+DROP INDEX IF EXISTS idx_tasks_updated_at;
+DROP INDEX IF EXISTS idx_tasks_user_created;
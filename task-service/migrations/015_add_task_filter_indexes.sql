@@ -0,0 +1,7 @@
+-- индексы под фильтры и сортировки ListTasks
+CREATE INDEX IF NOT EXISTS idx_tasks_user_created ON "Tasks"(user_id, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_updated_at ON "Tasks"(updated_at);
+CREATE INDEX IF NOT EXISTS idx_tasks_ended_at ON "Tasks"(ended_at) WHERE ended_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_attempts ON "Tasks"(attempts);
+-- text_prefix: lower(text) LIKE 'abc%'
+CREATE INDEX IF NOT EXISTS idx_tasks_text_prefix ON "Tasks"(lower(text) text_pattern_ops);
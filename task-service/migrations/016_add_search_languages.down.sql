@@ -0,0 +1,12 @@
+CREATE OR REPLACE FUNCTION tasks_search_vector_update() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector =
+        setweight(to_tsvector('russian', COALESCE(NEW.text, '')), 'A');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS idx_tasks_search_vector_simple;
+DROP INDEX IF EXISTS idx_tasks_search_vector_en;
+ALTER TABLE "Tasks" DROP COLUMN IF EXISTS search_vector_simple;
+ALTER TABLE "Tasks" DROP COLUMN IF EXISTS search_vector_en;
@@ -0,0 +1,22 @@
+-- поиск на русском и английском: свой tsvector на каждую конфигурацию,
+-- simple - без стемминга (имена, коды, смешанный текст)
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS search_vector_en tsvector;
+ALTER TABLE "Tasks" ADD COLUMN IF NOT EXISTS search_vector_simple tsvector;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_search_vector_en ON "Tasks" USING gin(search_vector_en);
+CREATE INDEX IF NOT EXISTS idx_tasks_search_vector_simple ON "Tasks" USING gin(search_vector_simple);
+
+CREATE OR REPLACE FUNCTION tasks_search_vector_update() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector =
+        setweight(to_tsvector('russian', COALESCE(NEW.text, '')), 'A');
+    NEW.search_vector_en =
+        setweight(to_tsvector('english', COALESCE(NEW.text, '')), 'A');
+    NEW.search_vector_simple =
+        setweight(to_tsvector('simple', COALESCE(NEW.text, '')), 'A');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+-- триггер пересчитает все вектора
+UPDATE "Tasks" SET text = text;
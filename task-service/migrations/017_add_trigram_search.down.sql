@@ -0,0 +1,2 @@
+-- расширение pg_trgm остаётся: его могут использовать не только задачи
+DROP INDEX IF EXISTS idx_tasks_text_trgm;
@@ -0,0 +1,4 @@
+-- поиск с опечатками (mode=fuzzy): word_similarity / <% по триграммам
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_text_trgm ON "Tasks" USING gin(text gin_trgm_ops);
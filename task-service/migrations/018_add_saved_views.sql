@@ -0,0 +1,14 @@
+-- сохранённые представления: фильтр списка и поисковый запрос пользователя
+CREATE TABLE IF NOT EXISTS saved_views (
+    id          SERIAL PRIMARY KEY,
+    user_id     TEXT NOT NULL,
+    name        TEXT NOT NULL,
+    query       TEXT NOT NULL DEFAULT '',      -- пусто - список (ListTasks), иначе поиск (SearchTasks)
+    search_mode TEXT NOT NULL DEFAULT '',      -- fulltext, fuzzy, prefix
+    language    TEXT NOT NULL DEFAULT '',
+    filter      JSONB NOT NULL DEFAULT '{}',   -- ListTasksRequest (protojson): фильтры и сортировка
+    created_at  TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_saved_views_user_id ON saved_views(user_id);
@@ -0,0 +1,7 @@
+-- события, которые не удалось отправить за maxAttempts попыток, откладываются (dead_at)
+-- и больше не забираются relay; отправленные удаляются по истечении OUTBOX_RETENTION
+ALTER TABLE outbox ADD COLUMN IF NOT EXISTS dead_at TIMESTAMP;
+
+DROP INDEX IF EXISTS idx_outbox_pending;
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at, id) WHERE sent_at IS NULL AND dead_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_sent ON outbox(sent_at) WHERE sent_at IS NOT NULL;
@@ -0,0 +1,8 @@
+// Package migrations встраивает SQL-миграции схемы в бинарник task-service.
+// NNN_name.sql - применение, NNN_name.down.sql - откат (необязателен)
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var Files embed.FS
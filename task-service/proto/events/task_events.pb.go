@@ -25,12 +25,19 @@ const (
 type TaskEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // CREATED, UPDATED, COMPLETED, DELETED
+	EventType     string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // CREATED, UPDATED, COMPLETED, DELETED, TASK_OVERDUE, COMMENT_ADDED ...
 	TaskId        int32                  `protobuf:"varint,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 	TaskText      string                 `protobuf:"bytes,4,opt,name=task_text,json=taskText,proto3" json:"task_text,omitempty"`
 	TaskStatus    string                 `protobuf:"bytes,5,opt,name=task_status,json=taskStatus,proto3" json:"task_status,omitempty"`
 	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FailureReason *FailureReason         `protobuf:"bytes,8,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"` // для TASK_FAILED: почему задача не прошла валидацию
+	Priority      string                 `protobuf:"bytes,9,opt,name=priority,proto3" json:"priority,omitempty"`                                // LOW, MEDIUM, HIGH, CRITICAL
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Tags          []string               `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	Comment       *Comment               `protobuf:"bytes,12,opt,name=comment,proto3" json:"comment,omitempty"`     // для COMMENT_ADDED
+	Assignees     []string               `protobuf:"bytes,13,rep,name=assignees,proto3" json:"assignees,omitempty"` // вместе с user_id - получатели уведомлений
+	Watchers      []string               `protobuf:"bytes,14,rep,name=watchers,proto3" json:"watchers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,6 +121,183 @@ func (x *TaskEvent) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *TaskEvent) GetFailureReason() *FailureReason {
+	if x != nil {
+		return x.FailureReason
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *TaskEvent) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetAssignees() []string {
+	if x != nil {
+		return x.Assignees
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetWatchers() []string {
+	if x != nil {
+		return x.Watchers
+	}
+	return nil
+}
+
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // автор
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_events_task_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_events_task_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_events_task_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Comment) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Comment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type FailureReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Validator     string                 `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailureReason) Reset() {
+	*x = FailureReason{}
+	mi := &file_events_task_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailureReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureReason) ProtoMessage() {}
+
+func (x *FailureReason) ProtoReflect() protoreflect.Message {
+	mi := &file_events_task_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureReason.ProtoReflect.Descriptor instead.
+func (*FailureReason) Descriptor() ([]byte, []int) {
+	return file_events_task_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FailureReason) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *FailureReason) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *FailureReason) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FailureReason) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
 type TaskBatchEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []*TaskEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
@@ -123,7 +307,7 @@ type TaskBatchEvent struct {
 
 func (x *TaskBatchEvent) Reset() {
 	*x = TaskBatchEvent{}
-	mi := &file_events_task_events_proto_msgTypes[1]
+	mi := &file_events_task_events_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -135,7 +319,7 @@ func (x *TaskBatchEvent) String() string {
 func (*TaskBatchEvent) ProtoMessage() {}
 
 func (x *TaskBatchEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_events_task_events_proto_msgTypes[1]
+	mi := &file_events_task_events_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -148,7 +332,7 @@ func (x *TaskBatchEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskBatchEvent.ProtoReflect.Descriptor instead.
 func (*TaskBatchEvent) Descriptor() ([]byte, []int) {
-	return file_events_task_events_proto_rawDescGZIP(), []int{1}
+	return file_events_task_events_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TaskBatchEvent) GetEvents() []*TaskEvent {
@@ -162,7 +346,7 @@ var File_events_task_events_proto protoreflect.FileDescriptor
 
 const file_events_task_events_proto_rawDesc = "" +
 	"\n" +
-	"\x18events/task_events.proto\x12\x06events\x1a\x1fgoogle/protobuf/timestamp.proto\"\xef\x01\n" +
+	"\x18events/task_events.proto\x12\x06events\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf5\x03\n" +
 	"\tTaskEvent\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
 	"\n" +
@@ -172,7 +356,27 @@ const file_events_task_events_proto_rawDesc = "" +
 	"\vtask_status\x18\x05 \x01(\tR\n" +
 	"taskStatus\x12\x17\n" +
 	"\auser_id\x18\x06 \x01(\tR\x06userId\x128\n" +
-	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\";\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12<\n" +
+	"\x0efailure_reason\x18\b \x01(\v2\x15.events.FailureReasonR\rfailureReason\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\tR\bpriority\x121\n" +
+	"\x06due_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12)\n" +
+	"\acomment\x18\f \x01(\v2\x0f.events.CommentR\acomment\x12\x1c\n" +
+	"\tassignees\x18\r \x03(\tR\tassignees\x12\x1a\n" +
+	"\bwatchers\x18\x0e \x03(\tR\bwatchers\"F\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\"\xd5\x01\n" +
+	"\rFailureReason\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1c\n" +
+	"\tvalidator\x18\x02 \x01(\tR\tvalidator\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12<\n" +
+	"\adetails\x18\x04 \x03(\v2\".events.FailureReason.DetailsEntryR\adetails\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
 	"\x0eTaskBatchEvent\x12)\n" +
 	"\x06events\x18\x01 \x03(\v2\x11.events.TaskEventR\x06eventsB\x1bZ\x19task-service/proto/eventsb\x06proto3"
 
@@ -188,20 +392,27 @@ func file_events_task_events_proto_rawDescGZIP() []byte {
 	return file_events_task_events_proto_rawDescData
 }
 
-var file_events_task_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_task_events_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_events_task_events_proto_goTypes = []any{
 	(*TaskEvent)(nil),             // 0: events.TaskEvent
-	(*TaskBatchEvent)(nil),        // 1: events.TaskBatchEvent
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*Comment)(nil),               // 1: events.Comment
+	(*FailureReason)(nil),         // 2: events.FailureReason
+	(*TaskBatchEvent)(nil),        // 3: events.TaskBatchEvent
+	nil,                           // 4: events.FailureReason.DetailsEntry
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
 }
 var file_events_task_events_proto_depIdxs = []int32{
-	2, // 0: events.TaskEvent.timestamp:type_name -> google.protobuf.Timestamp
-	0, // 1: events.TaskBatchEvent.events:type_name -> events.TaskEvent
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 0: events.TaskEvent.timestamp:type_name -> google.protobuf.Timestamp
+	2, // 1: events.TaskEvent.failure_reason:type_name -> events.FailureReason
+	5, // 2: events.TaskEvent.due_at:type_name -> google.protobuf.Timestamp
+	1, // 3: events.TaskEvent.comment:type_name -> events.Comment
+	4, // 4: events.FailureReason.details:type_name -> events.FailureReason.DetailsEntry
+	0, // 5: events.TaskBatchEvent.events:type_name -> events.TaskEvent
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_events_task_events_proto_init() }
@@ -215,7 +426,7 @@ func file_events_task_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_task_events_proto_rawDesc), len(file_events_task_events_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},